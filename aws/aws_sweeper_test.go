@@ -1,21 +1,130 @@
 package aws
 
 import (
+	"flag"
 	"fmt"
+	"log"
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/envvar"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 // sweeperAwsClients is a shared cache of regional AWSClient
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperAwsClients map[string]interface{}
 
+// sweepers are the sweepers registered with addSweeper and their test sweeper framework counterparts.
+var sweepers = make(map[*sweep.Sweeper]*resource.Sweeper)
+
+// sweepReport records the resources found by the sweepers registered with addSweeper.
+var sweepReport = &sweep.Report{}
+
 func TestMain(m *testing.M) {
 	sweeperAwsClients = make(map[string]interface{})
+
+	flag.Parse()
+	if f := flag.Lookup("sweep"); f != nil && f.Value.String() != "" {
+		addSweeperDerivedDependencies()
+	}
+
+	// Returns only after a successful sweep.
 	resource.TestMain(m)
+
+	if err := sweepReport.Write(os.Stdout); err != nil {
+		log.Printf("[ERROR] Error writing sweep report: %s", err)
+	}
+}
+
+// addSweeper registers a sweeper built on the shared sweeper library with the test sweeper framework.
+// Resources are deleted concurrently, up to SWEEP_PARALLELISM at a time. When SWEEP_DRY_RUN is set,
+// resources are only reported. The report of leaked resources is written to SWEEP_REPORT_FILE, if set.
+func addSweeper(s *sweep.Sweeper) {
+	sweeper := &resource.Sweeper{
+		Name:         s.Name,
+		Dependencies: s.Dependencies,
+		F: func(region string) error {
+			err := s.Sweep(region, sweepOptions())
+
+			if err := writeSweepReportFile(); err != nil {
+				log.Printf("[ERROR] Error writing sweep report: %s", err)
+			}
+
+			return err
+		},
+	}
+
+	sweepers[s] = sweeper
+
+	resource.AddTestSweepers(s.Name, sweeper)
+}
+
+// addSweeperDerivedDependencies adds the dependencies derived from the resource schemas
+// to the sweepers registered with addSweeper.
+func addSweeperDerivedDependencies() {
+	l := make([]*sweep.Sweeper, 0, len(sweepers))
+	for s := range sweepers {
+		l = append(l, s)
+	}
+
+	dependencies := sweep.DeriveDependencies(l, Provider().ResourcesMap)
+
+	for s, sweeper := range sweepers {
+		for _, dependency := range dependencies[s.Name] {
+			log.Printf("[DEBUG] Sweeper (%s) has derived dependency (%s)", s.Name, dependency)
+			sweeper.Dependencies = append(sweeper.Dependencies, dependency)
+		}
+	}
+}
+
+func sweepOptions() sweep.Options {
+	opts := sweep.Options{
+		Report:            sweepReport,
+		SkipSweepError:    testSweepSkipSweepError,
+		SkipResourceError: testSweepSkipResourceError,
+	}
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		opts.DryRun, _ = strconv.ParseBool(v)
+	}
+
+	if v := os.Getenv(envvar.SweepParallelism); v != "" {
+		opts.Parallelism, _ = strconv.Atoi(v)
+	}
+
+	return opts
+}
+
+func writeSweepReportFile() error {
+	filename := os.Getenv(envvar.SweepReportFile)
+
+	if filename == "" {
+		return nil
+	}
+
+	f, err := os.Create(filename)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	return sweepReport.Write(f)
+}
+
+// sweepSchemaResource returns a function deleting the resource with the ID through its Terraform resource Delete function.
+func sweepSchemaResource(r *schema.Resource, id string, client interface{}) func() error {
+	return func() error {
+		d := r.Data(nil)
+		d.SetId(id)
+
+		return r.Delete(d, client)
+	}
 }
 
 // sharedClientForRegion returns a common AWSClient setup needed for the sweeper
@@ -50,6 +50,7 @@ func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, er
 
 // RetryOnAwsCodes retries AWS error codes for one minute
 // Note: This function will be moved out of the aws package in the future.
+// Prefer tferrors.Retry, which retries classes of errors with a configurable timeout.
 func RetryOnAwsCodes(codes []string, f func() (interface{}, error)) (interface{}, error) {
 	var resp interface{}
 	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
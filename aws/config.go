@@ -1,13 +1,21 @@
 package aws
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
@@ -167,7 +175,16 @@ import (
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apitrace"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/credscache"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/regionpolicy"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/resourcepolicy"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/retrypolicy"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/semaphore"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tferrors"
 	"github.com/terraform-providers/terraform-provider-aws/version"
 )
 
@@ -179,6 +196,11 @@ type Config struct {
 	Token         string
 	Region        string
 	MaxRetries    int
+	RateLimits    map[string]ratelimit.Limit
+	RetryConfig   *retrypolicy.Config
+
+	// MaxConcurrentOperations limits the number of in-flight operations per service.
+	MaxConcurrentOperations map[string]int
 
 	AssumeRoleARN               string
 	AssumeRoleDurationSeconds   int
@@ -189,12 +211,22 @@ type Config struct {
 	AssumeRoleTags              map[string]string
 	AssumeRoleTransitiveTagKeys []string
 
+	// AssumeRoleChain holds the roles assumed in order after AssumeRoleARN.
+	AssumeRoleChain []*AssumeRole
+
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
+	PolicyValidation string
+	RegionPolicy     *regionpolicy.Config
+	ResourcePolicy   *resourcepolicy.Config
+
+	APITraceFile string
+
 	DefaultTagsConfig *keyvaluetags.DefaultConfig
 	Endpoints         map[string]string
 	IgnoreTagsConfig  *keyvaluetags.IgnoreConfig
+	TagPolicyConfig   *keyvaluetags.PolicyConfig
 	Insecure          bool
 
 	SkipCredsValidation     bool
@@ -207,174 +239,145 @@ type Config struct {
 	terraformVersion string
 }
 
+// defaultRetryableErrors are AWS API errors that are always retried,
+// in addition to any configured in the provider retry configuration block.
+var defaultRetryableErrors = []retrypolicy.RetryableError{
+	// Many operations can return an error such as:
+	//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
+	// Handle them all globally for the service client.
+	{
+		Service: apigateway.ServiceName,
+		Code:    apigateway.ErrCodeConflictException,
+		Message: "try again later",
+	},
+	{
+		Service:    appsync.ServiceName,
+		Operations: []string{"CreateGraphqlApi"},
+		Code:       appsync.ErrCodeConcurrentModificationException,
+		Message:    "a GraphQL API creation is already in progress",
+	},
+	// See https://github.com/aws/aws-sdk-go/pull/1276
+	{
+		Service:    dynamodb.ServiceName,
+		Operations: []string{"PutItem", "UpdateItem", "DeleteItem"},
+		Code:       dynamodb.ErrCodeLimitExceededException,
+		Message:    "Subscriber limit exceeded:",
+	},
+	{
+		Service:    ec2.ServiceName,
+		Operations: []string{"CreateClientVpnEndpoint"},
+		Code:       "OperationNotPermitted",
+		Message:    "Endpoint cannot be created while another endpoint is being created",
+	},
+	{
+		Service:    ec2.ServiceName,
+		Operations: []string{"CreateVpnConnection"},
+		Code:       "VpnConnectionLimitExceeded",
+		Message:    "maximum number of mutating objects has been reached",
+	},
+	{
+		Service:    ec2.ServiceName,
+		Operations: []string{"CreateVpnGateway"},
+		Code:       "VpnGatewayLimitExceeded",
+		Message:    "maximum number of mutating objects has been reached",
+	},
+	{
+		Service:    ec2.ServiceName,
+		Operations: []string{"AttachVpnGateway", "DetachVpnGateway"},
+		Code:       "InvalidParameterValue",
+		Message:    "This call cannot be completed because there are pending VPNs or Virtual Interfaces",
+	},
+	{
+		Service: kafka.ServiceName,
+		Code:    kafka.ErrCodeTooManyRequestsException,
+		Message: "Too Many Requests",
+	},
+	{
+		Service:    kinesis.ServiceName,
+		Operations: []string{"CreateStream"},
+		Code:       kinesis.ErrCodeLimitExceededException,
+		Message:    "simultaneously be in CREATING or DELETING",
+	},
+	{
+		Service:    kinesis.ServiceName,
+		Operations: []string{"CreateStream", "DeleteStream"},
+		Code:       kinesis.ErrCodeLimitExceededException,
+		Message:    "Rate exceeded for stream",
+	},
+	// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
+	{
+		Service: organizations.ServiceName,
+		Code:    organizations.ErrCodeConcurrentModificationException,
+		Message: "Try again later",
+	},
+	// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
+	{
+		Service: storagegateway.ServiceName,
+		Code:    storagegateway.ErrCodeInvalidGatewayRequestException,
+		Message: "The specified gateway proxy network connection is busy",
+	},
+	{
+		Service: wafv2.ServiceName,
+		Code:    wafv2.ErrCodeWAFInternalErrorException,
+		Message: "Retry your request",
+	},
+	{
+		Service: wafv2.ServiceName,
+		Code:    wafv2.ErrCodeWAFServiceLinkedRoleErrorException,
+		Message: "Retry",
+	},
+	// WAFv2 supports tag on create which can result in the below error codes according to the documentation
+	{
+		Service:    wafv2.ServiceName,
+		Operations: []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+		Code:       wafv2.ErrCodeWAFTagOperationException,
+		Message:    "Retry your request",
+	},
+	{
+		Service:    wafv2.ServiceName,
+		Operations: []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"},
+		Code:       wafv2.ErrCodeWAFTagOperationInternalErrorException,
+		Message:    "Retry your request",
+	},
+}
+
+// AssumeRole is a role assumed with the credentials of the role before it in a chain.
+type AssumeRole struct {
+	DurationSeconds   int
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	RoleARN           string
+	SessionName       string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
 type AWSClient struct {
-	accessanalyzerconn                  *accessanalyzer.AccessAnalyzer
-	accountid                           string
-	acmconn                             *acm.ACM
-	acmpcaconn                          *acmpca.ACMPCA
-	amplifyconn                         *amplify.Amplify
-	apigatewayconn                      *apigateway.APIGateway
-	apigatewayv2conn                    *apigatewayv2.ApiGatewayV2
-	appautoscalingconn                  *applicationautoscaling.ApplicationAutoScaling
-	applicationinsightsconn             *applicationinsights.ApplicationInsights
-	appmeshconn                         *appmesh.AppMesh
-	appstreamconn                       *appstream.AppStream
-	appsyncconn                         *appsync.AppSync
-	athenaconn                          *athena.Athena
-	auditmanagerconn                    *auditmanager.AuditManager
-	autoscalingconn                     *autoscaling.AutoScaling
-	autoscalingplansconn                *autoscalingplans.AutoScalingPlans
-	backupconn                          *backup.Backup
-	batchconn                           *batch.Batch
-	budgetconn                          *budgets.Budgets
-	cfconn                              *cloudformation.CloudFormation
-	cloud9conn                          *cloud9.Cloud9
-	cloudfrontconn                      *cloudfront.CloudFront
-	cloudhsmv2conn                      *cloudhsmv2.CloudHSMV2
-	cloudsearchconn                     *cloudsearch.CloudSearch
-	cloudtrailconn                      *cloudtrail.CloudTrail
-	cloudwatchconn                      *cloudwatch.CloudWatch
-	cloudwatcheventsconn                *cloudwatchevents.CloudWatchEvents
-	cloudwatchlogsconn                  *cloudwatchlogs.CloudWatchLogs
-	codeartifactconn                    *codeartifact.CodeArtifact
-	codebuildconn                       *codebuild.CodeBuild
-	codecommitconn                      *codecommit.CodeCommit
-	codedeployconn                      *codedeploy.CodeDeploy
-	codepipelineconn                    *codepipeline.CodePipeline
-	codestarconnectionsconn             *codestarconnections.CodeStarConnections
-	codestarnotificationsconn           *codestarnotifications.CodeStarNotifications
-	cognitoconn                         *cognitoidentity.CognitoIdentity
-	cognitoidpconn                      *cognitoidentityprovider.CognitoIdentityProvider
-	configconn                          *configservice.ConfigService
-	connectconn                         *connect.Connect
-	costandusagereportconn              *costandusagereportservice.CostandUsageReportService
-	dataexchangeconn                    *dataexchange.DataExchange
-	datapipelineconn                    *datapipeline.DataPipeline
-	datasyncconn                        *datasync.DataSync
-	daxconn                             *dax.DAX
-	DefaultTagsConfig                   *keyvaluetags.DefaultConfig
-	devicefarmconn                      *devicefarm.DeviceFarm
-	dlmconn                             *dlm.DLM
-	dmsconn                             *databasemigrationservice.DatabaseMigrationService
-	dnsSuffix                           string
-	docdbconn                           *docdb.DocDB
-	dsconn                              *directoryservice.DirectoryService
-	dxconn                              *directconnect.DirectConnect
-	dynamodbconn                        *dynamodb.DynamoDB
-	ec2conn                             *ec2.EC2
-	ecrconn                             *ecr.ECR
-	ecrpublicconn                       *ecrpublic.ECRPublic
-	ecsconn                             *ecs.ECS
-	efsconn                             *efs.EFS
-	eksconn                             *eks.EKS
-	elasticacheconn                     *elasticache.ElastiCache
-	elasticbeanstalkconn                *elasticbeanstalk.ElasticBeanstalk
-	elastictranscoderconn               *elastictranscoder.ElasticTranscoder
-	elbconn                             *elb.ELB
-	elbv2conn                           *elbv2.ELBV2
-	emrconn                             *emr.EMR
-	emrcontainersconn                   *emrcontainers.EMRContainers
-	esconn                              *elasticsearch.ElasticsearchService
-	firehoseconn                        *firehose.Firehose
-	fmsconn                             *fms.FMS
-	forecastconn                        *forecastservice.ForecastService
-	fsxconn                             *fsx.FSx
-	gameliftconn                        *gamelift.GameLift
-	glacierconn                         *glacier.Glacier
-	globalacceleratorconn               *globalaccelerator.GlobalAccelerator
-	glueconn                            *glue.Glue
-	guarddutyconn                       *guardduty.GuardDuty
-	greengrassconn                      *greengrass.Greengrass
-	iamconn                             *iam.IAM
-	identitystoreconn                   *identitystore.IdentityStore
-	IgnoreTagsConfig                    *keyvaluetags.IgnoreConfig
-	imagebuilderconn                    *imagebuilder.Imagebuilder
-	inspectorconn                       *inspector.Inspector
-	iotconn                             *iot.IoT
-	iotanalyticsconn                    *iotanalytics.IoTAnalytics
-	ioteventsconn                       *iotevents.IoTEvents
-	kafkaconn                           *kafka.Kafka
-	kinesisanalyticsconn                *kinesisanalytics.KinesisAnalytics
-	kinesisanalyticsv2conn              *kinesisanalyticsv2.KinesisAnalyticsV2
-	kinesisconn                         *kinesis.Kinesis
-	kinesisvideoconn                    *kinesisvideo.KinesisVideo
-	kmsconn                             *kms.KMS
-	lakeformationconn                   *lakeformation.LakeFormation
-	lambdaconn                          *lambda.Lambda
-	lexmodelconn                        *lexmodelbuildingservice.LexModelBuildingService
-	licensemanagerconn                  *licensemanager.LicenseManager
-	lightsailconn                       *lightsail.Lightsail
-	macieconn                           *macie.Macie
-	macie2conn                          *macie2.Macie2
-	managedblockchainconn               *managedblockchain.ManagedBlockchain
-	marketplacecatalogconn              *marketplacecatalog.MarketplaceCatalog
-	mediaconnectconn                    *mediaconnect.MediaConnect
-	mediaconvertconn                    *mediaconvert.MediaConvert
-	mediaconvertaccountconn             *mediaconvert.MediaConvert
-	medialiveconn                       *medialive.MediaLive
-	mediapackageconn                    *mediapackage.MediaPackage
-	mediastoreconn                      *mediastore.MediaStore
-	mediastoredataconn                  *mediastoredata.MediaStoreData
-	mqconn                              *mq.MQ
-	mwaaconn                            *mwaa.MWAA
-	neptuneconn                         *neptune.Neptune
-	networkfirewallconn                 *networkfirewall.NetworkFirewall
-	networkmanagerconn                  *networkmanager.NetworkManager
-	opsworksconn                        *opsworks.OpsWorks
-	organizationsconn                   *organizations.Organizations
-	outpostsconn                        *outposts.Outposts
-	partition                           string
-	personalizeconn                     *personalize.Personalize
-	prometheusserviceconn               *prometheusservice.PrometheusService
-	pinpointconn                        *pinpoint.Pinpoint
-	pricingconn                         *pricing.Pricing
-	qldbconn                            *qldb.QLDB
-	quicksightconn                      *quicksight.QuickSight
-	r53conn                             *route53.Route53
-	ramconn                             *ram.RAM
-	rdsconn                             *rds.RDS
-	redshiftconn                        *redshift.Redshift
-	region                              string
-	resourcegroupsconn                  *resourcegroups.ResourceGroups
-	resourcegroupstaggingapiconn        *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
-	reverseDnsPrefix                    string
-	route53domainsconn                  *route53domains.Route53Domains
-	route53resolverconn                 *route53resolver.Route53Resolver
-	s3conn                              *s3.S3
-	s3connUriCleaningDisabled           *s3.S3
-	s3controlconn                       *s3control.S3Control
-	s3outpostsconn                      *s3outposts.S3Outposts
-	sagemakerconn                       *sagemaker.SageMaker
-	scconn                              *servicecatalog.ServiceCatalog
-	sdconn                              *servicediscovery.ServiceDiscovery
-	secretsmanagerconn                  *secretsmanager.SecretsManager
-	securityhubconn                     *securityhub.SecurityHub
-	serverlessapplicationrepositoryconn *serverlessapplicationrepository.ServerlessApplicationRepository
-	servicequotasconn                   *servicequotas.ServiceQuotas
-	sesconn                             *ses.SES
-	sfnconn                             *sfn.SFN
-	shieldconn                          *shield.Shield
-	signerconn                          *signer.Signer
-	simpledbconn                        *simpledb.SimpleDB
-	snsconn                             *sns.SNS
-	sqsconn                             *sqs.SQS
-	ssmconn                             *ssm.SSM
-	ssoadminconn                        *ssoadmin.SSOAdmin
-	storagegatewayconn                  *storagegateway.StorageGateway
-	stsconn                             *sts.STS
-	supportedplatforms                  []string
-	swfconn                             *swf.SWF
-	syntheticsconn                      *synthetics.Synthetics
-	terraformVersion                    string
-	timestreamwriteconn                 *timestreamwrite.TimestreamWrite
-	transferconn                        *transfer.Transfer
-	wafconn                             *waf.WAF
-	wafregionalconn                     *wafregional.WAFRegional
-	wafv2conn                           *wafv2.WAFV2
-	worklinkconn                        *worklink.WorkLink
-	workmailconn                        *workmail.WorkMail
-	workspacesconn                      *workspaces.WorkSpaces
-	xrayconn                            *xray.XRay
+	accountid               string
+	apiTracer               *apitrace.Tracer
+	concurrencyLimits       semaphore.Limits
+	DefaultTagsConfig       *keyvaluetags.DefaultConfig
+	dnsSuffix               string
+	endpoints               map[string]string
+	IgnoreTagsConfig        *keyvaluetags.IgnoreConfig
+	TagPolicyConfig         *keyvaluetags.PolicyConfig
+	mediaconvertaccountconn *mediaconvert.MediaConvert
+	partition               string
+	policyValidation        string
+	region                  string
+	regionPolicy            *regionpolicy.Config
+	resourcePolicy          *resourcepolicy.Config
+	reverseDnsPrefix        string
+	s3ForcePathStyle        bool
+	session                 *session.Session
+	supportedplatforms      []string
+	terraformVersion        string
+
+	// conns holds the service clients created on first use, keyed by
+	// connection method name.
+	conns     map[string]interface{}
+	connsLock sync.Mutex
 }
 
 // PartitionHostname returns a hostname with the provider domain suffix for the partition
@@ -391,7 +394,79 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.region, client.dnsSuffix)
 }
 
-// Client configures and returns a fully initialized AWSClient
+// ValidateRegion returns an error if the provider allowed_regions, forbidden_regions
+// or allowed_partitions do not permit the Region.
+func (client *AWSClient) ValidateRegion(region string) error {
+	return client.regionPolicy.Validate(region)
+}
+
+// AcquireConcurrentOperation waits, at most for the timeout, for one of the
+// operations allowed concurrently for the service by the provider
+// max_concurrent_operations. The returned function must be called to release
+// the operation once it, including any waiting for its completion, is done.
+func (client *AWSClient) AcquireConcurrentOperation(service string, timeout time.Duration) (func(), error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return client.concurrencyLimits.Acquire(ctx, service)
+}
+
+// customizeDiffValidateRegion returns a CustomizeDiff function that validates the
+// Region in the named top-level attribute with the provider Region policy.
+func customizeDiffValidateRegion(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		region, ok := diff.Get(key).(string)
+
+		if !ok || region == "" {
+			return nil
+		}
+
+		if err := meta.(*AWSClient).ValidateRegion(region); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+
+		return nil
+	}
+}
+
+// customizeDiffValidateNestedRegions returns a CustomizeDiff function that validates the
+// Region in the named attribute of each element of a configuration block set or list
+// with the provider Region policy.
+func customizeDiffValidateNestedRegions(blockKey, key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		var l []interface{}
+
+		switch v := diff.Get(blockKey).(type) {
+		case *schema.Set:
+			l = v.List()
+		case []interface{}:
+			l = v
+		}
+
+		for _, tfMapRaw := range l {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			region, ok := tfMap[key].(string)
+
+			if !ok || region == "" {
+				continue
+			}
+
+			if err := meta.(*AWSClient).ValidateRegion(region); err != nil {
+				return fmt.Errorf("%s.%s: %w", blockKey, key, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// Client configures and returns a fully initialized AWSClient.
+// Service clients are created on first use by their connection methods.
 func (c *Config) Client() (interface{}, error) {
 	// Get the auth and region. This can fail if keys/regions were not
 	// specified and we're attempting to use the environment.
@@ -401,6 +476,11 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	// Enforced regardless of skip_region_validation.
+	if err := c.RegionPolicy.ValidateRegion(c.Region); err != nil {
+		return nil, err
+	}
+
 	awsbaseConfig := &awsbase.Config{
 		AccessKey:                   c.AccessKey,
 		AssumeRoleARN:               c.AssumeRoleARN,
@@ -434,11 +514,35 @@ func (c *Config) Client() (interface{}, error) {
 		},
 	}
 
-	sess, accountID, partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
+	sess, accountID, partition, err := getSessionWithCachedCredentials(awsbaseConfig, c.AssumeRoleChain)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
 
+	// Apply the provider retry policy uniformly to all service clients.
+	sess = sess.Copy(request.WithRetryer(aws.NewConfig(), c.RetryConfig.Retryer(c.MaxRetries)))
+	sess.Handlers.Retry.PushBack(retrypolicy.RetryHandler(append(defaultRetryableErrors, c.RetryConfig.RetryableErrors()...)))
+
+	// Attach the API operation and request ID to errors for diagnostics.
+	sess.Handlers.Complete.PushBack(tferrors.CompleteHandler)
+
+	// Pace requests to rate limited services before each attempt is signed.
+	if len(c.RateLimits) > 0 {
+		sess.Handlers.Sign.PushFront(ratelimit.SignHandler(accountID, c.RateLimits))
+	}
+
+	var apiTracer *apitrace.Tracer
+
+	if c.APITraceFile != "" {
+		apiTracer, err = apitrace.Open(c.APITraceFile)
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+		}
+
+		sess.Handlers.Retry.PushBack(apiTracer.RetryHandler)
+		sess.Handlers.Complete.PushBack(apiTracer.CompleteHandler)
+	}
+
 	if accountID == "" {
 		log.Printf("[WARN] AWS account ID not found for provider. See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for implications.")
 	}
@@ -447,370 +551,199 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	if err := c.RegionPolicy.ValidatePartition(partition); err != nil {
+		return nil, err
+	}
+
 	dnsSuffix := "amazonaws.com"
 	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
 		dnsSuffix = p.DNSSuffix()
 	}
 
 	client := &AWSClient{
-		accessanalyzerconn:                  accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["accessanalyzer"])})),
-		accountid:                           accountID,
-		acmconn:                             acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acm"])})),
-		acmpcaconn:                          acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["acmpca"])})),
-		amplifyconn:                         amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["amplify"])})),
-		apigatewayconn:                      apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
-		apigatewayv2conn:                    apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["apigateway"])})),
-		appautoscalingconn:                  applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationautoscaling"])})),
-		applicationinsightsconn:             applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["applicationinsights"])})),
-		appmeshconn:                         appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appmesh"])})),
-		appstreamconn:                       appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appstream"])})),
-		appsyncconn:                         appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["appsync"])})),
-		athenaconn:                          athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["athena"])})),
-		auditmanagerconn:                    auditmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["auditmanager"])})),
-		autoscalingconn:                     autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["autoscaling"])})),
-		autoscalingplansconn:                autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["autoscalingplans"])})),
-		backupconn:                          backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["backup"])})),
-		batchconn:                           batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["batch"])})),
-		budgetconn:                          budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["budgets"])})),
-		cfconn:                              cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudformation"])})),
-		cloud9conn:                          cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloud9"])})),
-		cloudfrontconn:                      cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudfront"])})),
-		cloudhsmv2conn:                      cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudhsm"])})),
-		cloudsearchconn:                     cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudsearch"])})),
-		cloudtrailconn:                      cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudtrail"])})),
-		cloudwatchconn:                      cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatch"])})),
-		cloudwatcheventsconn:                cloudwatchevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatchevents"])})),
-		cloudwatchlogsconn:                  cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cloudwatchlogs"])})),
-		codeartifactconn:                    codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codeartifact"])})),
-		codebuildconn:                       codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codebuild"])})),
-		codecommitconn:                      codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codecommit"])})),
-		codedeployconn:                      codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codedeploy"])})),
-		codepipelineconn:                    codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codepipeline"])})),
-		codestarconnectionsconn:             codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codestarconnections"])})),
-		codestarnotificationsconn:           codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codestarnotifications"])})),
-		cognitoconn:                         cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidentity"])})),
-		cognitoidpconn:                      cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidp"])})),
-		configconn:                          configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["configservice"])})),
-		connectconn:                         connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["connect"])})),
-		costandusagereportconn:              costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cur"])})),
-		dataexchangeconn:                    dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dataexchange"])})),
-		datapipelineconn:                    datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datapipeline"])})),
-		datasyncconn:                        datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["datasync"])})),
-		daxconn:                             dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dax"])})),
-		DefaultTagsConfig:                   c.DefaultTagsConfig,
-		devicefarmconn:                      devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["devicefarm"])})),
-		dlmconn:                             dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dlm"])})),
-		dmsconn:                             databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dms"])})),
-		dnsSuffix:                           dnsSuffix,
-		docdbconn:                           docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["docdb"])})),
-		dsconn:                              directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ds"])})),
-		dxconn:                              directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["directconnect"])})),
-		dynamodbconn:                        dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["dynamodb"])})),
-		ec2conn:                             ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ec2"])})),
-		ecrconn:                             ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecr"])})),
-		ecrpublicconn:                       ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecrpublic"])})),
-		ecsconn:                             ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ecs"])})),
-		efsconn:                             efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["efs"])})),
-		eksconn:                             eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["eks"])})),
-		elasticacheconn:                     elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elasticache"])})),
-		elasticbeanstalkconn:                elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elasticbeanstalk"])})),
-		elastictranscoderconn:               elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elastictranscoder"])})),
-		elbconn:                             elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])})),
-		elbv2conn:                           elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["elb"])})),
-		emrconn:                             emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["emr"])})),
-		emrcontainersconn:                   emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["emrcontainers"])})),
-		esconn:                              elasticsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["es"])})),
-		firehoseconn:                        firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["firehose"])})),
-		fmsconn:                             fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fms"])})),
-		forecastconn:                        forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["forecast"])})),
-		fsxconn:                             fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["fsx"])})),
-		gameliftconn:                        gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["gamelift"])})),
-		glacierconn:                         glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glacier"])})),
-		glueconn:                            glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["glue"])})),
-		guarddutyconn:                       guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["guardduty"])})),
-		greengrassconn:                      greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["greengrass"])})),
-		iamconn:                             iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iam"])})),
-		identitystoreconn:                   identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["identitystore"])})),
-		IgnoreTagsConfig:                    c.IgnoreTagsConfig,
-		imagebuilderconn:                    imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["imagebuilder"])})),
-		inspectorconn:                       inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["inspector"])})),
-		iotconn:                             iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iot"])})),
-		iotanalyticsconn:                    iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iotanalytics"])})),
-		ioteventsconn:                       iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["iotevents"])})),
-		kafkaconn:                           kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kafka"])})),
-		kinesisanalyticsconn:                kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisanalytics"])})),
-		kinesisanalyticsv2conn:              kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisanalyticsv2"])})),
-		kinesisconn:                         kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesis"])})),
-		kinesisvideoconn:                    kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kinesisvideo"])})),
-		kmsconn:                             kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["kms"])})),
-		lakeformationconn:                   lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lakeformation"])})),
-		lambdaconn:                          lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lambda"])})),
-		lexmodelconn:                        lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lexmodels"])})),
-		licensemanagerconn:                  licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["licensemanager"])})),
-		lightsailconn:                       lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["lightsail"])})),
-		macieconn:                           macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["macie"])})),
-		macie2conn:                          macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["macie2"])})),
-		managedblockchainconn:               managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["managedblockchain"])})),
-		marketplacecatalogconn:              marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["marketplacecatalog"])})),
-		mediaconnectconn:                    mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediaconnect"])})),
-		mediaconvertconn:                    mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediaconvert"])})),
-		medialiveconn:                       medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["medialive"])})),
-		mediapackageconn:                    mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediapackage"])})),
-		mediastoreconn:                      mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediastore"])})),
-		mediastoredataconn:                  mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mediastoredata"])})),
-		mqconn:                              mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mq"])})),
-		mwaaconn:                            mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["mwaa"])})),
-		neptuneconn:                         neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["neptune"])})),
-		networkfirewallconn:                 networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["networkfirewall"])})),
-		networkmanagerconn:                  networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["networkmanager"])})),
-		opsworksconn:                        opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["opsworks"])})),
-		organizationsconn:                   organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["organizations"])})),
-		outpostsconn:                        outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["outposts"])})),
-		partition:                           partition,
-		personalizeconn:                     personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["personalize"])})),
-		prometheusserviceconn:               prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["prometheusservice"])})),
-		pinpointconn:                        pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pinpoint"])})),
-		pricingconn:                         pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["pricing"])})),
-		qldbconn:                            qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["qldb"])})),
-		quicksightconn:                      quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["quicksight"])})),
-		ramconn:                             ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ram"])})),
-		rdsconn:                             rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["rds"])})),
-		redshiftconn:                        redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["redshift"])})),
-		region:                              c.Region,
-		resourcegroupsconn:                  resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroups"])})),
-		resourcegroupstaggingapiconn:        resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["resourcegroupstaggingapi"])})),
-		reverseDnsPrefix:                    ReverseDns(dnsSuffix),
-		route53domainsconn:                  route53domains.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53domains"])})),
-		route53resolverconn:                 route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["route53resolver"])})),
-		s3controlconn:                       s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3control"])})),
-		s3outpostsconn:                      s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["s3outposts"])})),
-		sagemakerconn:                       sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sagemaker"])})),
-		scconn:                              servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicecatalog"])})),
-		sdconn:                              servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicediscovery"])})),
-		secretsmanagerconn:                  secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["secretsmanager"])})),
-		securityhubconn:                     securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["securityhub"])})),
-		serverlessapplicationrepositoryconn: serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["serverlessrepo"])})),
-		servicequotasconn:                   servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["servicequotas"])})),
-		sesconn:                             ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ses"])})),
-		sfnconn:                             sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["stepfunctions"])})),
-		signerconn:                          signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["signer"])})),
-		simpledbconn:                        simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sdb"])})),
-		snsconn:                             sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sns"])})),
-		sqsconn:                             sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sqs"])})),
-		ssmconn:                             ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ssm"])})),
-		ssoadminconn:                        ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ssoadmin"])})),
-		storagegatewayconn:                  storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["storagegateway"])})),
-		stsconn:                             sts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sts"])})),
-		swfconn:                             swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["swf"])})),
-		syntheticsconn:                      synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["synthetics"])})),
-		terraformVersion:                    c.terraformVersion,
-		timestreamwriteconn:                 timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["timestreamwrite"])})),
-		transferconn:                        transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["transfer"])})),
-		wafconn:                             waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["waf"])})),
-		wafregionalconn:                     wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["wafregional"])})),
-		wafv2conn:                           wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["wafv2"])})),
-		worklinkconn:                        worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["worklink"])})),
-		workmailconn:                        workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["workmail"])})),
-		workspacesconn:                      workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["workspaces"])})),
-		xrayconn:                            xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["xray"])})),
-	}
-
-	// "Global" services that require customizations
-	globalAcceleratorConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints["globalaccelerator"]),
-	}
-	route53Config := &aws.Config{
-		Endpoint: aws.String(c.Endpoints["route53"]),
-	}
-	shieldConfig := &aws.Config{
-		Endpoint: aws.String(c.Endpoints["shield"]),
-	}
-
-	// Services that require multiple client configurations
-	s3Config := &aws.Config{
-		Endpoint:         aws.String(c.Endpoints["s3"]),
-		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
-	}
-
-	client.s3conn = s3.New(sess.Copy(s3Config))
-
-	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
-	client.s3connUriCleaningDisabled = s3.New(sess.Copy(s3Config))
-
-	// Force "global" services to correct regions
-	switch partition {
-	case endpoints.AwsPartitionID:
-		globalAcceleratorConfig.Region = aws.String(endpoints.UsWest2RegionID)
-		route53Config.Region = aws.String(endpoints.UsEast1RegionID)
-		shieldConfig.Region = aws.String(endpoints.UsEast1RegionID)
-	case endpoints.AwsCnPartitionID:
-		// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
-		// This can likely be removed in the future.
-		if aws.StringValue(route53Config.Endpoint) == "" {
-			route53Config.Endpoint = aws.String("https://api.route53.cn")
+		accountid:         accountID,
+		apiTracer:         apiTracer,
+		concurrencyLimits: semaphore.NewLimits(c.MaxConcurrentOperations),
+		conns:             make(map[string]interface{}),
+		DefaultTagsConfig: c.DefaultTagsConfig,
+		dnsSuffix:         dnsSuffix,
+		endpoints:         c.Endpoints,
+		IgnoreTagsConfig:  c.IgnoreTagsConfig,
+		TagPolicyConfig:   c.TagPolicyConfig,
+		partition:         partition,
+		policyValidation:  c.PolicyValidation,
+		region:            c.Region,
+		regionPolicy:      c.RegionPolicy,
+		resourcePolicy:    c.ResourcePolicy,
+		reverseDnsPrefix:  ReverseDns(dnsSuffix),
+		s3ForcePathStyle:  c.S3ForcePathStyle,
+		session:           sess,
+		terraformVersion:  c.terraformVersion,
+	}
+
+	if !c.SkipGetEC2Platforms {
+		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn())
+		if err != nil {
+			// We intentionally fail *silently* because there's a chance
+			// user just doesn't have ec2:DescribeAccountAttributes permissions
+			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+		} else {
+			client.supportedplatforms = supportedPlatforms
 		}
-		route53Config.Region = aws.String(endpoints.CnNorthwest1RegionID)
-	case endpoints.AwsUsGovPartitionID:
-		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
 	}
 
-	client.globalacceleratorconn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.r53conn = route53.New(sess.Copy(route53Config))
-	client.shieldconn = shield.New(sess.Copy(shieldConfig))
+	return client, nil
+}
 
-	client.apigatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Many operations can return an error such as:
-		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
-		// Handle them all globally for the service client.
-		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+// getSessionWithCachedCredentials returns a session, account ID and partition like
+// awsbase.GetSessionWithAccountIDAndPartition followed by assuming each role in the chain,
+// sharing the resolved credentials between provider configurations in the process that
+// authenticate identically.
+// This avoids repeatedly running credential processes and assuming the same role.
+func getSessionWithCachedCredentials(awsbaseConfig *awsbase.Config, assumeRoleChain []*AssumeRole) (*session.Session, string, string, error) {
+	chainKey, err := json.Marshal(assumeRoleChain)
 
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	if err != nil {
+		return nil, "", "", err
+	}
 
-	client.appsyncconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateGraphqlApi" {
-			if isAWSErr(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+	key := credscache.Key{
+		AccessKey:                   awsbaseConfig.AccessKey,
+		AssumeRoleARN:               awsbaseConfig.AssumeRoleARN,
+		AssumeRoleChain:             string(chainKey),
+		AssumeRoleDurationSeconds:   awsbaseConfig.AssumeRoleDurationSeconds,
+		AssumeRoleExternalID:        awsbaseConfig.AssumeRoleExternalID,
+		AssumeRolePolicy:            awsbaseConfig.AssumeRolePolicy,
+		AssumeRolePolicyARNs:        credscache.JoinList(awsbaseConfig.AssumeRolePolicyARNs),
+		AssumeRoleSessionName:       awsbaseConfig.AssumeRoleSessionName,
+		AssumeRoleTags:              credscache.JoinMap(awsbaseConfig.AssumeRoleTags),
+		AssumeRoleTransitiveTagKeys: credscache.JoinList(awsbaseConfig.AssumeRoleTransitiveTagKeys),
+		CredsFilename:               awsbaseConfig.CredsFilename,
+		Profile:                     awsbaseConfig.Profile,
+		SecretKey:                   awsbaseConfig.SecretKey,
+		SkipCredsValidation:         awsbaseConfig.SkipCredsValidation,
+		SkipRequestingAccountId:     awsbaseConfig.SkipRequestingAccountId,
+		StsEndpoint:                 awsbaseConfig.StsEndpoint,
+		Token:                       awsbaseConfig.Token,
+	}
 
-	client.configconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling Config Organization Rules API actions immediately
-		// after Organization creation, the API can randomly return the
-		// OrganizationAccessDeniedException error for a few minutes, even
-		// after succeeding a few requests.
-		switch r.Operation.Name {
-		case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
-			if !isAWSErr(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
-				return
-			}
+	var sess *session.Session
 
-			// We only want to retry briefly as the default max retry count would
-			// excessively retry when the error could be legitimate.
-			// We currently depend on the DefaultRetryer exponential backoff here.
-			// ~10 retries gives a fair backoff of a few seconds.
-			if r.RetryCount < 9 {
-				r.Retryable = aws.Bool(true)
-			} else {
-				r.Retryable = aws.Bool(false)
-			}
-		}
-	})
+	entry, cached, err := credscache.Load(key, func() (*credscache.Entry, error) {
+		var accountID, partition string
+		var err error
 
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.dynamodbconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+		sess, accountID, partition, err = awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
 
-	client.ec2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateClientVpnEndpoint" {
-			if isAWSErr(r.Error, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
-				r.Retryable = aws.Bool(true)
-			}
+		if err != nil {
+			return nil, err
 		}
 
-		if r.Operation.Name == "CreateVpnConnection" {
-			if isAWSErr(r.Error, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
+		for _, assumeRole := range assumeRoleChain {
+			sess, accountID, partition, err = assumeRoleSession(sess, assumeRole)
 
-		if r.Operation.Name == "CreateVpnGateway" {
-			if isAWSErr(r.Error, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
+			if err != nil {
+				return nil, err
 			}
 		}
 
-		if r.Operation.Name == "AttachVpnGateway" || r.Operation.Name == "DetachVpnGateway" {
-			if isAWSErr(r.Error, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
+		return &credscache.Entry{
+			Credentials: sess.Config.Credentials,
+			AccountID:   accountID,
+			Partition:   partition,
+		}, nil
 	})
 
-	client.kafkaconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	if err != nil {
+		return nil, "", "", err
+	}
 
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if isAWSErr(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+	if !cached {
+		return sess, entry.AccountID, entry.Partition, nil
+	}
 
-	client.organizationsconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Retry on the following error:
-		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
-		if isAWSErr(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	value, err := entry.Credentials.Get()
 
-	client.storagegatewayconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
-		if isAWSErr(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	if err != nil {
+		return nil, "", "", fmt.Errorf("error retrieving cached credentials: %w", err)
+	}
 
-	client.wafv2conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if isAWSErr(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
-			r.Retryable = aws.Bool(true)
-		}
+	// Build the session from the already validated credentials, then share the
+	// cached credentials so that they are refreshed once when they expire.
+	cachedConfig := *awsbaseConfig
+	cachedConfig.AccessKey = value.AccessKeyID
+	cachedConfig.SecretKey = value.SecretAccessKey
+	cachedConfig.Token = value.SessionToken
+	cachedConfig.AssumeRoleARN = ""
+	cachedConfig.SkipCredsValidation = true
 
-		if isAWSErr(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
-			r.Retryable = aws.Bool(true)
-		}
+	sess, err = awsbase.GetSession(&cachedConfig)
 
-		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
-			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
-			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
-			if isAWSErr(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
-			if isAWSErr(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
+	if err != nil {
+		return nil, "", "", err
+	}
 
-	if !c.SkipGetEC2Platforms {
-		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
-		if err != nil {
-			// We intentionally fail *silently* because there's a chance
-			// user just doesn't have ec2:DescribeAccountAttributes permissions
-			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
-		} else {
-			client.supportedplatforms = supportedPlatforms
-		}
+	sess = sess.Copy(&aws.Config{Credentials: entry.Credentials})
+
+	return sess, entry.AccountID, entry.Partition, nil
+}
+
+// assumeRoleSession returns a copy of the session using the credentials of the
+// assumed role, along with the account ID and partition of the role.
+func assumeRoleSession(sess *session.Session, assumeRole *AssumeRole) (*session.Session, string, string, error) {
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
+
+	provider := &stscreds.AssumeRoleProvider{
+		Client:  sts.New(sess),
+		RoleARN: assumeRole.RoleARN,
 	}
 
-	return client, nil
+	if assumeRole.DurationSeconds > 0 {
+		provider.Duration = time.Duration(assumeRole.DurationSeconds) * time.Second
+	}
+
+	if assumeRole.ExternalID != "" {
+		provider.ExternalID = aws.String(assumeRole.ExternalID)
+	}
+
+	if assumeRole.Policy != "" {
+		provider.Policy = aws.String(assumeRole.Policy)
+	}
+
+	for _, policyARN := range assumeRole.PolicyARNs {
+		provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(policyARN),
+		})
+	}
+
+	if assumeRole.SessionName != "" {
+		provider.RoleSessionName = assumeRole.SessionName
+	}
+
+	for k, v := range assumeRole.Tags {
+		provider.Tags = append(provider.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	if len(assumeRole.TransitiveTagKeys) > 0 {
+		provider.TransitiveTagKeys = aws.StringSlice(assumeRole.TransitiveTagKeys)
+	}
+
+	creds := credentials.NewCredentials(provider)
+
+	if _, err := creds.Get(); err != nil {
+		return nil, "", "", fmt.Errorf("error assuming IAM Role (%s): %w", assumeRole.RoleARN, err)
+	}
+
+	roleARN, err := arn.Parse(assumeRole.RoleARN)
+
+	if err != nil {
+		return nil, "", "", fmt.Errorf("error parsing IAM Role ARN (%s): %w", assumeRole.RoleARN, err)
+	}
+
+	return sess.Copy(&aws.Config{Credentials: creds}), roleARN.AccountID, roleARN.Partition, nil
 }
 
 func hasEc2Classic(platforms []string) bool {
@@ -849,3 +782,1043 @@ func GetSupportedEC2Platforms(conn *ec2.EC2) ([]string, error) {
 
 	return platforms, nil
 }
+
+// serviceConn returns the service client cached under name, calling newConn to
+// create it on first use. Creating service clients lazily keeps the cost of
+// configuring the provider independent of the number of supported services.
+func (client *AWSClient) serviceConn(name string, newConn func() interface{}) interface{} {
+	client.connsLock.Lock()
+	defer client.connsLock.Unlock()
+
+	if conn, ok := client.conns[name]; ok {
+		return conn
+	}
+
+	conn := newConn()
+	client.conns[name] = conn
+
+	return conn
+}
+
+func (client *AWSClient) accessanalyzerconn() *accessanalyzer.AccessAnalyzer {
+	return client.serviceConn("accessanalyzerconn", func() interface{} {
+		return accessanalyzer.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["accessanalyzer"])}))
+	}).(*accessanalyzer.AccessAnalyzer)
+}
+
+func (client *AWSClient) acmconn() *acm.ACM {
+	return client.serviceConn("acmconn", func() interface{} {
+		return acm.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["acm"])}))
+	}).(*acm.ACM)
+}
+
+func (client *AWSClient) acmpcaconn() *acmpca.ACMPCA {
+	return client.serviceConn("acmpcaconn", func() interface{} {
+		return acmpca.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["acmpca"])}))
+	}).(*acmpca.ACMPCA)
+}
+
+func (client *AWSClient) amplifyconn() *amplify.Amplify {
+	return client.serviceConn("amplifyconn", func() interface{} {
+		return amplify.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["amplify"])}))
+	}).(*amplify.Amplify)
+}
+
+func (client *AWSClient) apigatewayconn() *apigateway.APIGateway {
+	return client.serviceConn("apigatewayconn", func() interface{} {
+		return apigateway.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["apigateway"])}))
+	}).(*apigateway.APIGateway)
+}
+
+func (client *AWSClient) apigatewayv2conn() *apigatewayv2.ApiGatewayV2 {
+	return client.serviceConn("apigatewayv2conn", func() interface{} {
+		return apigatewayv2.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["apigateway"])}))
+	}).(*apigatewayv2.ApiGatewayV2)
+}
+
+func (client *AWSClient) appautoscalingconn() *applicationautoscaling.ApplicationAutoScaling {
+	return client.serviceConn("appautoscalingconn", func() interface{} {
+		conn := applicationautoscaling.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["applicationautoscaling"])}))
+
+		// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+				return
+			}
+			if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
+				r.Retryable = aws.Bool(true)
+			}
+		})
+
+		return conn
+	}).(*applicationautoscaling.ApplicationAutoScaling)
+}
+
+func (client *AWSClient) applicationinsightsconn() *applicationinsights.ApplicationInsights {
+	return client.serviceConn("applicationinsightsconn", func() interface{} {
+		return applicationinsights.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["applicationinsights"])}))
+	}).(*applicationinsights.ApplicationInsights)
+}
+
+func (client *AWSClient) appmeshconn() *appmesh.AppMesh {
+	return client.serviceConn("appmeshconn", func() interface{} {
+		return appmesh.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["appmesh"])}))
+	}).(*appmesh.AppMesh)
+}
+
+func (client *AWSClient) appstreamconn() *appstream.AppStream {
+	return client.serviceConn("appstreamconn", func() interface{} {
+		return appstream.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["appstream"])}))
+	}).(*appstream.AppStream)
+}
+
+func (client *AWSClient) appsyncconn() *appsync.AppSync {
+	return client.serviceConn("appsyncconn", func() interface{} {
+		return appsync.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["appsync"])}))
+	}).(*appsync.AppSync)
+}
+
+func (client *AWSClient) athenaconn() *athena.Athena {
+	return client.serviceConn("athenaconn", func() interface{} {
+		return athena.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["athena"])}))
+	}).(*athena.Athena)
+}
+
+func (client *AWSClient) auditmanagerconn() *auditmanager.AuditManager {
+	return client.serviceConn("auditmanagerconn", func() interface{} {
+		return auditmanager.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["auditmanager"])}))
+	}).(*auditmanager.AuditManager)
+}
+
+func (client *AWSClient) autoscalingconn() *autoscaling.AutoScaling {
+	return client.serviceConn("autoscalingconn", func() interface{} {
+		return autoscaling.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["autoscaling"])}))
+	}).(*autoscaling.AutoScaling)
+}
+
+func (client *AWSClient) autoscalingplansconn() *autoscalingplans.AutoScalingPlans {
+	return client.serviceConn("autoscalingplansconn", func() interface{} {
+		return autoscalingplans.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["autoscalingplans"])}))
+	}).(*autoscalingplans.AutoScalingPlans)
+}
+
+func (client *AWSClient) backupconn() *backup.Backup {
+	return client.serviceConn("backupconn", func() interface{} {
+		return backup.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["backup"])}))
+	}).(*backup.Backup)
+}
+
+func (client *AWSClient) batchconn() *batch.Batch {
+	return client.serviceConn("batchconn", func() interface{} {
+		return batch.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["batch"])}))
+	}).(*batch.Batch)
+}
+
+func (client *AWSClient) budgetconn() *budgets.Budgets {
+	return client.serviceConn("budgetconn", func() interface{} {
+		return budgets.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["budgets"])}))
+	}).(*budgets.Budgets)
+}
+
+func (client *AWSClient) cfconn() *cloudformation.CloudFormation {
+	return client.serviceConn("cfconn", func() interface{} {
+		return cloudformation.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudformation"])}))
+	}).(*cloudformation.CloudFormation)
+}
+
+func (client *AWSClient) cloud9conn() *cloud9.Cloud9 {
+	return client.serviceConn("cloud9conn", func() interface{} {
+		return cloud9.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloud9"])}))
+	}).(*cloud9.Cloud9)
+}
+
+func (client *AWSClient) cloudfrontconn() *cloudfront.CloudFront {
+	return client.serviceConn("cloudfrontconn", func() interface{} {
+		return cloudfront.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudfront"])}))
+	}).(*cloudfront.CloudFront)
+}
+
+func (client *AWSClient) cloudhsmv2conn() *cloudhsmv2.CloudHSMV2 {
+	return client.serviceConn("cloudhsmv2conn", func() interface{} {
+		return cloudhsmv2.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudhsm"])}))
+	}).(*cloudhsmv2.CloudHSMV2)
+}
+
+func (client *AWSClient) cloudsearchconn() *cloudsearch.CloudSearch {
+	return client.serviceConn("cloudsearchconn", func() interface{} {
+		return cloudsearch.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudsearch"])}))
+	}).(*cloudsearch.CloudSearch)
+}
+
+func (client *AWSClient) cloudtrailconn() *cloudtrail.CloudTrail {
+	return client.serviceConn("cloudtrailconn", func() interface{} {
+		return cloudtrail.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudtrail"])}))
+	}).(*cloudtrail.CloudTrail)
+}
+
+func (client *AWSClient) cloudwatchconn() *cloudwatch.CloudWatch {
+	return client.serviceConn("cloudwatchconn", func() interface{} {
+		return cloudwatch.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudwatch"])}))
+	}).(*cloudwatch.CloudWatch)
+}
+
+func (client *AWSClient) cloudwatcheventsconn() *cloudwatchevents.CloudWatchEvents {
+	return client.serviceConn("cloudwatcheventsconn", func() interface{} {
+		return cloudwatchevents.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudwatchevents"])}))
+	}).(*cloudwatchevents.CloudWatchEvents)
+}
+
+func (client *AWSClient) cloudwatchlogsconn() *cloudwatchlogs.CloudWatchLogs {
+	return client.serviceConn("cloudwatchlogsconn", func() interface{} {
+		return cloudwatchlogs.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cloudwatchlogs"])}))
+	}).(*cloudwatchlogs.CloudWatchLogs)
+}
+
+func (client *AWSClient) codeartifactconn() *codeartifact.CodeArtifact {
+	return client.serviceConn("codeartifactconn", func() interface{} {
+		return codeartifact.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["codeartifact"])}))
+	}).(*codeartifact.CodeArtifact)
+}
+
+func (client *AWSClient) codebuildconn() *codebuild.CodeBuild {
+	return client.serviceConn("codebuildconn", func() interface{} {
+		return codebuild.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["codebuild"])}))
+	}).(*codebuild.CodeBuild)
+}
+
+func (client *AWSClient) codecommitconn() *codecommit.CodeCommit {
+	return client.serviceConn("codecommitconn", func() interface{} {
+		return codecommit.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["codecommit"])}))
+	}).(*codecommit.CodeCommit)
+}
+
+func (client *AWSClient) codedeployconn() *codedeploy.CodeDeploy {
+	return client.serviceConn("codedeployconn", func() interface{} {
+		return codedeploy.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["codedeploy"])}))
+	}).(*codedeploy.CodeDeploy)
+}
+
+func (client *AWSClient) codepipelineconn() *codepipeline.CodePipeline {
+	return client.serviceConn("codepipelineconn", func() interface{} {
+		return codepipeline.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["codepipeline"])}))
+	}).(*codepipeline.CodePipeline)
+}
+
+func (client *AWSClient) codestarconnectionsconn() *codestarconnections.CodeStarConnections {
+	return client.serviceConn("codestarconnectionsconn", func() interface{} {
+		return codestarconnections.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["codestarconnections"])}))
+	}).(*codestarconnections.CodeStarConnections)
+}
+
+func (client *AWSClient) codestarnotificationsconn() *codestarnotifications.CodeStarNotifications {
+	return client.serviceConn("codestarnotificationsconn", func() interface{} {
+		return codestarnotifications.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["codestarnotifications"])}))
+	}).(*codestarnotifications.CodeStarNotifications)
+}
+
+func (client *AWSClient) cognitoconn() *cognitoidentity.CognitoIdentity {
+	return client.serviceConn("cognitoconn", func() interface{} {
+		return cognitoidentity.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cognitoidentity"])}))
+	}).(*cognitoidentity.CognitoIdentity)
+}
+
+func (client *AWSClient) cognitoidpconn() *cognitoidentityprovider.CognitoIdentityProvider {
+	return client.serviceConn("cognitoidpconn", func() interface{} {
+		return cognitoidentityprovider.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cognitoidp"])}))
+	}).(*cognitoidentityprovider.CognitoIdentityProvider)
+}
+
+func (client *AWSClient) configconn() *configservice.ConfigService {
+	return client.serviceConn("configconn", func() interface{} {
+		conn := configservice.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["configservice"])}))
+
+		conn.Handlers.Retry.PushBack(func(r *request.Request) {
+			// When calling Config Organization Rules API actions immediately
+			// after Organization creation, the API can randomly return the
+			// OrganizationAccessDeniedException error for a few minutes, even
+			// after succeeding a few requests.
+			switch r.Operation.Name {
+			case "DeleteOrganizationConfigRule", "DescribeOrganizationConfigRules", "DescribeOrganizationConfigRuleStatuses", "PutOrganizationConfigRule":
+				if !isAWSErr(r.Error, configservice.ErrCodeOrganizationAccessDeniedException, "This action can be only made by AWS Organization's master account.") {
+					return
+				}
+
+				// We only want to retry briefly as the default max retry count would
+				// excessively retry when the error could be legitimate.
+				// We currently depend on the DefaultRetryer exponential backoff here.
+				// ~10 retries gives a fair backoff of a few seconds.
+				if r.RetryCount < 9 {
+					r.Retryable = aws.Bool(true)
+				} else {
+					r.Retryable = aws.Bool(false)
+				}
+			}
+		})
+
+		return conn
+	}).(*configservice.ConfigService)
+}
+
+func (client *AWSClient) connectconn() *connect.Connect {
+	return client.serviceConn("connectconn", func() interface{} {
+		return connect.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["connect"])}))
+	}).(*connect.Connect)
+}
+
+func (client *AWSClient) costandusagereportconn() *costandusagereportservice.CostandUsageReportService {
+	return client.serviceConn("costandusagereportconn", func() interface{} {
+		return costandusagereportservice.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["cur"])}))
+	}).(*costandusagereportservice.CostandUsageReportService)
+}
+
+func (client *AWSClient) dataexchangeconn() *dataexchange.DataExchange {
+	return client.serviceConn("dataexchangeconn", func() interface{} {
+		return dataexchange.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["dataexchange"])}))
+	}).(*dataexchange.DataExchange)
+}
+
+func (client *AWSClient) datapipelineconn() *datapipeline.DataPipeline {
+	return client.serviceConn("datapipelineconn", func() interface{} {
+		return datapipeline.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["datapipeline"])}))
+	}).(*datapipeline.DataPipeline)
+}
+
+func (client *AWSClient) datasyncconn() *datasync.DataSync {
+	return client.serviceConn("datasyncconn", func() interface{} {
+		return datasync.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["datasync"])}))
+	}).(*datasync.DataSync)
+}
+
+func (client *AWSClient) daxconn() *dax.DAX {
+	return client.serviceConn("daxconn", func() interface{} {
+		return dax.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["dax"])}))
+	}).(*dax.DAX)
+}
+
+func (client *AWSClient) devicefarmconn() *devicefarm.DeviceFarm {
+	return client.serviceConn("devicefarmconn", func() interface{} {
+		return devicefarm.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["devicefarm"])}))
+	}).(*devicefarm.DeviceFarm)
+}
+
+func (client *AWSClient) dlmconn() *dlm.DLM {
+	return client.serviceConn("dlmconn", func() interface{} {
+		return dlm.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["dlm"])}))
+	}).(*dlm.DLM)
+}
+
+func (client *AWSClient) dmsconn() *databasemigrationservice.DatabaseMigrationService {
+	return client.serviceConn("dmsconn", func() interface{} {
+		return databasemigrationservice.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["dms"])}))
+	}).(*databasemigrationservice.DatabaseMigrationService)
+}
+
+func (client *AWSClient) docdbconn() *docdb.DocDB {
+	return client.serviceConn("docdbconn", func() interface{} {
+		return docdb.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["docdb"])}))
+	}).(*docdb.DocDB)
+}
+
+func (client *AWSClient) dsconn() *directoryservice.DirectoryService {
+	return client.serviceConn("dsconn", func() interface{} {
+		return directoryservice.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ds"])}))
+	}).(*directoryservice.DirectoryService)
+}
+
+func (client *AWSClient) dxconn() *directconnect.DirectConnect {
+	return client.serviceConn("dxconn", func() interface{} {
+		return directconnect.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["directconnect"])}))
+	}).(*directconnect.DirectConnect)
+}
+
+func (client *AWSClient) dynamodbconn() *dynamodb.DynamoDB {
+	return client.serviceConn("dynamodbconn", func() interface{} {
+		return dynamodb.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["dynamodb"])}))
+	}).(*dynamodb.DynamoDB)
+}
+
+func (client *AWSClient) ec2conn() *ec2.EC2 {
+	return client.serviceConn("ec2conn", func() interface{} {
+		return ec2.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ec2"])}))
+	}).(*ec2.EC2)
+}
+
+func (client *AWSClient) ecrconn() *ecr.ECR {
+	return client.serviceConn("ecrconn", func() interface{} {
+		return ecr.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ecr"])}))
+	}).(*ecr.ECR)
+}
+
+func (client *AWSClient) ecrpublicconn() *ecrpublic.ECRPublic {
+	return client.serviceConn("ecrpublicconn", func() interface{} {
+		return ecrpublic.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ecrpublic"])}))
+	}).(*ecrpublic.ECRPublic)
+}
+
+func (client *AWSClient) ecsconn() *ecs.ECS {
+	return client.serviceConn("ecsconn", func() interface{} {
+		return ecs.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ecs"])}))
+	}).(*ecs.ECS)
+}
+
+func (client *AWSClient) efsconn() *efs.EFS {
+	return client.serviceConn("efsconn", func() interface{} {
+		return efs.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["efs"])}))
+	}).(*efs.EFS)
+}
+
+func (client *AWSClient) eksconn() *eks.EKS {
+	return client.serviceConn("eksconn", func() interface{} {
+		return eks.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["eks"])}))
+	}).(*eks.EKS)
+}
+
+func (client *AWSClient) elasticacheconn() *elasticache.ElastiCache {
+	return client.serviceConn("elasticacheconn", func() interface{} {
+		return elasticache.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["elasticache"])}))
+	}).(*elasticache.ElastiCache)
+}
+
+func (client *AWSClient) elasticbeanstalkconn() *elasticbeanstalk.ElasticBeanstalk {
+	return client.serviceConn("elasticbeanstalkconn", func() interface{} {
+		return elasticbeanstalk.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["elasticbeanstalk"])}))
+	}).(*elasticbeanstalk.ElasticBeanstalk)
+}
+
+func (client *AWSClient) elastictranscoderconn() *elastictranscoder.ElasticTranscoder {
+	return client.serviceConn("elastictranscoderconn", func() interface{} {
+		return elastictranscoder.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["elastictranscoder"])}))
+	}).(*elastictranscoder.ElasticTranscoder)
+}
+
+func (client *AWSClient) elbconn() *elb.ELB {
+	return client.serviceConn("elbconn", func() interface{} {
+		return elb.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["elb"])}))
+	}).(*elb.ELB)
+}
+
+func (client *AWSClient) elbv2conn() *elbv2.ELBV2 {
+	return client.serviceConn("elbv2conn", func() interface{} {
+		return elbv2.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["elb"])}))
+	}).(*elbv2.ELBV2)
+}
+
+func (client *AWSClient) emrconn() *emr.EMR {
+	return client.serviceConn("emrconn", func() interface{} {
+		return emr.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["emr"])}))
+	}).(*emr.EMR)
+}
+
+func (client *AWSClient) emrcontainersconn() *emrcontainers.EMRContainers {
+	return client.serviceConn("emrcontainersconn", func() interface{} {
+		return emrcontainers.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["emrcontainers"])}))
+	}).(*emrcontainers.EMRContainers)
+}
+
+func (client *AWSClient) esconn() *elasticsearch.ElasticsearchService {
+	return client.serviceConn("esconn", func() interface{} {
+		return elasticsearch.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["es"])}))
+	}).(*elasticsearch.ElasticsearchService)
+}
+
+func (client *AWSClient) firehoseconn() *firehose.Firehose {
+	return client.serviceConn("firehoseconn", func() interface{} {
+		return firehose.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["firehose"])}))
+	}).(*firehose.Firehose)
+}
+
+func (client *AWSClient) fmsconn() *fms.FMS {
+	return client.serviceConn("fmsconn", func() interface{} {
+		return fms.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["fms"])}))
+	}).(*fms.FMS)
+}
+
+func (client *AWSClient) forecastconn() *forecastservice.ForecastService {
+	return client.serviceConn("forecastconn", func() interface{} {
+		return forecastservice.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["forecast"])}))
+	}).(*forecastservice.ForecastService)
+}
+
+func (client *AWSClient) fsxconn() *fsx.FSx {
+	return client.serviceConn("fsxconn", func() interface{} {
+		return fsx.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["fsx"])}))
+	}).(*fsx.FSx)
+}
+
+func (client *AWSClient) gameliftconn() *gamelift.GameLift {
+	return client.serviceConn("gameliftconn", func() interface{} {
+		return gamelift.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["gamelift"])}))
+	}).(*gamelift.GameLift)
+}
+
+func (client *AWSClient) glacierconn() *glacier.Glacier {
+	return client.serviceConn("glacierconn", func() interface{} {
+		return glacier.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["glacier"])}))
+	}).(*glacier.Glacier)
+}
+
+func (client *AWSClient) globalacceleratorconn() *globalaccelerator.GlobalAccelerator {
+	return client.serviceConn("globalacceleratorconn", func() interface{} {
+		config := &aws.Config{
+			Endpoint: aws.String(client.endpoints["globalaccelerator"]),
+		}
+
+		// Force "global" service to correct region
+		if client.partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsWest2RegionID)
+		}
+
+		return globalaccelerator.New(client.session.Copy(config))
+	}).(*globalaccelerator.GlobalAccelerator)
+}
+
+func (client *AWSClient) glueconn() *glue.Glue {
+	return client.serviceConn("glueconn", func() interface{} {
+		return glue.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["glue"])}))
+	}).(*glue.Glue)
+}
+
+func (client *AWSClient) greengrassconn() *greengrass.Greengrass {
+	return client.serviceConn("greengrassconn", func() interface{} {
+		return greengrass.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["greengrass"])}))
+	}).(*greengrass.Greengrass)
+}
+
+func (client *AWSClient) guarddutyconn() *guardduty.GuardDuty {
+	return client.serviceConn("guarddutyconn", func() interface{} {
+		return guardduty.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["guardduty"])}))
+	}).(*guardduty.GuardDuty)
+}
+
+func (client *AWSClient) iamconn() *iam.IAM {
+	return client.serviceConn("iamconn", func() interface{} {
+		return iam.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["iam"])}))
+	}).(*iam.IAM)
+}
+
+func (client *AWSClient) identitystoreconn() *identitystore.IdentityStore {
+	return client.serviceConn("identitystoreconn", func() interface{} {
+		return identitystore.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["identitystore"])}))
+	}).(*identitystore.IdentityStore)
+}
+
+func (client *AWSClient) imagebuilderconn() *imagebuilder.Imagebuilder {
+	return client.serviceConn("imagebuilderconn", func() interface{} {
+		return imagebuilder.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["imagebuilder"])}))
+	}).(*imagebuilder.Imagebuilder)
+}
+
+func (client *AWSClient) inspectorconn() *inspector.Inspector {
+	return client.serviceConn("inspectorconn", func() interface{} {
+		return inspector.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["inspector"])}))
+	}).(*inspector.Inspector)
+}
+
+func (client *AWSClient) iotanalyticsconn() *iotanalytics.IoTAnalytics {
+	return client.serviceConn("iotanalyticsconn", func() interface{} {
+		return iotanalytics.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["iotanalytics"])}))
+	}).(*iotanalytics.IoTAnalytics)
+}
+
+func (client *AWSClient) iotconn() *iot.IoT {
+	return client.serviceConn("iotconn", func() interface{} {
+		return iot.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["iot"])}))
+	}).(*iot.IoT)
+}
+
+func (client *AWSClient) ioteventsconn() *iotevents.IoTEvents {
+	return client.serviceConn("ioteventsconn", func() interface{} {
+		return iotevents.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["iotevents"])}))
+	}).(*iotevents.IoTEvents)
+}
+
+func (client *AWSClient) kafkaconn() *kafka.Kafka {
+	return client.serviceConn("kafkaconn", func() interface{} {
+		return kafka.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["kafka"])}))
+	}).(*kafka.Kafka)
+}
+
+func (client *AWSClient) kinesisanalyticsconn() *kinesisanalytics.KinesisAnalytics {
+	return client.serviceConn("kinesisanalyticsconn", func() interface{} {
+		return kinesisanalytics.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["kinesisanalytics"])}))
+	}).(*kinesisanalytics.KinesisAnalytics)
+}
+
+func (client *AWSClient) kinesisanalyticsv2conn() *kinesisanalyticsv2.KinesisAnalyticsV2 {
+	return client.serviceConn("kinesisanalyticsv2conn", func() interface{} {
+		return kinesisanalyticsv2.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["kinesisanalyticsv2"])}))
+	}).(*kinesisanalyticsv2.KinesisAnalyticsV2)
+}
+
+func (client *AWSClient) kinesisconn() *kinesis.Kinesis {
+	return client.serviceConn("kinesisconn", func() interface{} {
+		return kinesis.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["kinesis"])}))
+	}).(*kinesis.Kinesis)
+}
+
+func (client *AWSClient) kinesisvideoconn() *kinesisvideo.KinesisVideo {
+	return client.serviceConn("kinesisvideoconn", func() interface{} {
+		return kinesisvideo.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["kinesisvideo"])}))
+	}).(*kinesisvideo.KinesisVideo)
+}
+
+func (client *AWSClient) kmsconn() *kms.KMS {
+	return client.serviceConn("kmsconn", func() interface{} {
+		return kms.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["kms"])}))
+	}).(*kms.KMS)
+}
+
+func (client *AWSClient) lakeformationconn() *lakeformation.LakeFormation {
+	return client.serviceConn("lakeformationconn", func() interface{} {
+		return lakeformation.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["lakeformation"])}))
+	}).(*lakeformation.LakeFormation)
+}
+
+func (client *AWSClient) lambdaconn() *lambda.Lambda {
+	return client.serviceConn("lambdaconn", func() interface{} {
+		return lambda.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["lambda"])}))
+	}).(*lambda.Lambda)
+}
+
+func (client *AWSClient) lexmodelconn() *lexmodelbuildingservice.LexModelBuildingService {
+	return client.serviceConn("lexmodelconn", func() interface{} {
+		return lexmodelbuildingservice.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["lexmodels"])}))
+	}).(*lexmodelbuildingservice.LexModelBuildingService)
+}
+
+func (client *AWSClient) licensemanagerconn() *licensemanager.LicenseManager {
+	return client.serviceConn("licensemanagerconn", func() interface{} {
+		return licensemanager.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["licensemanager"])}))
+	}).(*licensemanager.LicenseManager)
+}
+
+func (client *AWSClient) lightsailconn() *lightsail.Lightsail {
+	return client.serviceConn("lightsailconn", func() interface{} {
+		return lightsail.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["lightsail"])}))
+	}).(*lightsail.Lightsail)
+}
+
+func (client *AWSClient) macie2conn() *macie2.Macie2 {
+	return client.serviceConn("macie2conn", func() interface{} {
+		return macie2.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["macie2"])}))
+	}).(*macie2.Macie2)
+}
+
+func (client *AWSClient) macieconn() *macie.Macie {
+	return client.serviceConn("macieconn", func() interface{} {
+		return macie.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["macie"])}))
+	}).(*macie.Macie)
+}
+
+func (client *AWSClient) managedblockchainconn() *managedblockchain.ManagedBlockchain {
+	return client.serviceConn("managedblockchainconn", func() interface{} {
+		return managedblockchain.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["managedblockchain"])}))
+	}).(*managedblockchain.ManagedBlockchain)
+}
+
+func (client *AWSClient) marketplacecatalogconn() *marketplacecatalog.MarketplaceCatalog {
+	return client.serviceConn("marketplacecatalogconn", func() interface{} {
+		return marketplacecatalog.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["marketplacecatalog"])}))
+	}).(*marketplacecatalog.MarketplaceCatalog)
+}
+
+func (client *AWSClient) mediaconnectconn() *mediaconnect.MediaConnect {
+	return client.serviceConn("mediaconnectconn", func() interface{} {
+		return mediaconnect.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mediaconnect"])}))
+	}).(*mediaconnect.MediaConnect)
+}
+
+func (client *AWSClient) mediaconvertconn() *mediaconvert.MediaConvert {
+	return client.serviceConn("mediaconvertconn", func() interface{} {
+		return mediaconvert.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mediaconvert"])}))
+	}).(*mediaconvert.MediaConvert)
+}
+
+func (client *AWSClient) medialiveconn() *medialive.MediaLive {
+	return client.serviceConn("medialiveconn", func() interface{} {
+		return medialive.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["medialive"])}))
+	}).(*medialive.MediaLive)
+}
+
+func (client *AWSClient) mediapackageconn() *mediapackage.MediaPackage {
+	return client.serviceConn("mediapackageconn", func() interface{} {
+		return mediapackage.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mediapackage"])}))
+	}).(*mediapackage.MediaPackage)
+}
+
+func (client *AWSClient) mediastoreconn() *mediastore.MediaStore {
+	return client.serviceConn("mediastoreconn", func() interface{} {
+		return mediastore.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mediastore"])}))
+	}).(*mediastore.MediaStore)
+}
+
+func (client *AWSClient) mediastoredataconn() *mediastoredata.MediaStoreData {
+	return client.serviceConn("mediastoredataconn", func() interface{} {
+		return mediastoredata.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mediastoredata"])}))
+	}).(*mediastoredata.MediaStoreData)
+}
+
+func (client *AWSClient) mqconn() *mq.MQ {
+	return client.serviceConn("mqconn", func() interface{} {
+		return mq.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mq"])}))
+	}).(*mq.MQ)
+}
+
+func (client *AWSClient) mwaaconn() *mwaa.MWAA {
+	return client.serviceConn("mwaaconn", func() interface{} {
+		return mwaa.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["mwaa"])}))
+	}).(*mwaa.MWAA)
+}
+
+func (client *AWSClient) neptuneconn() *neptune.Neptune {
+	return client.serviceConn("neptuneconn", func() interface{} {
+		return neptune.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["neptune"])}))
+	}).(*neptune.Neptune)
+}
+
+func (client *AWSClient) networkfirewallconn() *networkfirewall.NetworkFirewall {
+	return client.serviceConn("networkfirewallconn", func() interface{} {
+		return networkfirewall.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["networkfirewall"])}))
+	}).(*networkfirewall.NetworkFirewall)
+}
+
+func (client *AWSClient) networkmanagerconn() *networkmanager.NetworkManager {
+	return client.serviceConn("networkmanagerconn", func() interface{} {
+		return networkmanager.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["networkmanager"])}))
+	}).(*networkmanager.NetworkManager)
+}
+
+func (client *AWSClient) opsworksconn() *opsworks.OpsWorks {
+	return client.serviceConn("opsworksconn", func() interface{} {
+		return opsworks.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["opsworks"])}))
+	}).(*opsworks.OpsWorks)
+}
+
+func (client *AWSClient) organizationsconn() *organizations.Organizations {
+	return client.serviceConn("organizationsconn", func() interface{} {
+		return organizations.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["organizations"])}))
+	}).(*organizations.Organizations)
+}
+
+func (client *AWSClient) outpostsconn() *outposts.Outposts {
+	return client.serviceConn("outpostsconn", func() interface{} {
+		return outposts.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["outposts"])}))
+	}).(*outposts.Outposts)
+}
+
+func (client *AWSClient) personalizeconn() *personalize.Personalize {
+	return client.serviceConn("personalizeconn", func() interface{} {
+		return personalize.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["personalize"])}))
+	}).(*personalize.Personalize)
+}
+
+func (client *AWSClient) pinpointconn() *pinpoint.Pinpoint {
+	return client.serviceConn("pinpointconn", func() interface{} {
+		return pinpoint.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["pinpoint"])}))
+	}).(*pinpoint.Pinpoint)
+}
+
+func (client *AWSClient) pricingconn() *pricing.Pricing {
+	return client.serviceConn("pricingconn", func() interface{} {
+		return pricing.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["pricing"])}))
+	}).(*pricing.Pricing)
+}
+
+func (client *AWSClient) prometheusserviceconn() *prometheusservice.PrometheusService {
+	return client.serviceConn("prometheusserviceconn", func() interface{} {
+		return prometheusservice.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["prometheusservice"])}))
+	}).(*prometheusservice.PrometheusService)
+}
+
+func (client *AWSClient) qldbconn() *qldb.QLDB {
+	return client.serviceConn("qldbconn", func() interface{} {
+		return qldb.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["qldb"])}))
+	}).(*qldb.QLDB)
+}
+
+func (client *AWSClient) quicksightconn() *quicksight.QuickSight {
+	return client.serviceConn("quicksightconn", func() interface{} {
+		return quicksight.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["quicksight"])}))
+	}).(*quicksight.QuickSight)
+}
+
+func (client *AWSClient) r53conn() *route53.Route53 {
+	return client.serviceConn("r53conn", func() interface{} {
+		config := &aws.Config{
+			Endpoint: aws.String(client.endpoints["route53"]),
+		}
+
+		// Force "global" service to correct region
+		switch client.partition {
+		case endpoints.AwsPartitionID:
+			config.Region = aws.String(endpoints.UsEast1RegionID)
+		case endpoints.AwsCnPartitionID:
+			// The AWS Go SDK is missing endpoint information for Route 53 in the AWS China partition.
+			// This can likely be removed in the future.
+			if aws.StringValue(config.Endpoint) == "" {
+				config.Endpoint = aws.String("https://api.route53.cn")
+			}
+			config.Region = aws.String(endpoints.CnNorthwest1RegionID)
+		case endpoints.AwsUsGovPartitionID:
+			config.Region = aws.String(endpoints.UsGovWest1RegionID)
+		}
+
+		return route53.New(client.session.Copy(config))
+	}).(*route53.Route53)
+}
+
+func (client *AWSClient) ramconn() *ram.RAM {
+	return client.serviceConn("ramconn", func() interface{} {
+		return ram.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ram"])}))
+	}).(*ram.RAM)
+}
+
+func (client *AWSClient) rdsconn() *rds.RDS {
+	return client.serviceConn("rdsconn", func() interface{} {
+		return rds.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["rds"])}))
+	}).(*rds.RDS)
+}
+
+func (client *AWSClient) redshiftconn() *redshift.Redshift {
+	return client.serviceConn("redshiftconn", func() interface{} {
+		return redshift.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["redshift"])}))
+	}).(*redshift.Redshift)
+}
+
+func (client *AWSClient) resourcegroupsconn() *resourcegroups.ResourceGroups {
+	return client.serviceConn("resourcegroupsconn", func() interface{} {
+		return resourcegroups.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["resourcegroups"])}))
+	}).(*resourcegroups.ResourceGroups)
+}
+
+func (client *AWSClient) resourcegroupstaggingapiconn() *resourcegroupstaggingapi.ResourceGroupsTaggingAPI {
+	return client.serviceConn("resourcegroupstaggingapiconn", func() interface{} {
+		return resourcegroupstaggingapi.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["resourcegroupstaggingapi"])}))
+	}).(*resourcegroupstaggingapi.ResourceGroupsTaggingAPI)
+}
+
+func (client *AWSClient) route53domainsconn() *route53domains.Route53Domains {
+	return client.serviceConn("route53domainsconn", func() interface{} {
+		return route53domains.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["route53domains"])}))
+	}).(*route53domains.Route53Domains)
+}
+
+func (client *AWSClient) route53resolverconn() *route53resolver.Route53Resolver {
+	return client.serviceConn("route53resolverconn", func() interface{} {
+		return route53resolver.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["route53resolver"])}))
+	}).(*route53resolver.Route53Resolver)
+}
+
+func (client *AWSClient) s3conn() *s3.S3 {
+	return client.serviceConn("s3conn", func() interface{} {
+		return s3.New(client.session.Copy(&aws.Config{
+			Endpoint:         aws.String(client.endpoints["s3"]),
+			S3ForcePathStyle: aws.Bool(client.s3ForcePathStyle),
+		}))
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) s3connUriCleaningDisabled() *s3.S3 {
+	return client.serviceConn("s3connUriCleaningDisabled", func() interface{} {
+		return s3.New(client.session.Copy(&aws.Config{
+			DisableRestProtocolURICleaning: aws.Bool(true),
+			Endpoint:                       aws.String(client.endpoints["s3"]),
+			S3ForcePathStyle:               aws.Bool(client.s3ForcePathStyle),
+		}))
+	}).(*s3.S3)
+}
+
+func (client *AWSClient) s3controlconn() *s3control.S3Control {
+	return client.serviceConn("s3controlconn", func() interface{} {
+		return s3control.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["s3control"])}))
+	}).(*s3control.S3Control)
+}
+
+func (client *AWSClient) s3outpostsconn() *s3outposts.S3Outposts {
+	return client.serviceConn("s3outpostsconn", func() interface{} {
+		return s3outposts.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["s3outposts"])}))
+	}).(*s3outposts.S3Outposts)
+}
+
+func (client *AWSClient) sagemakerconn() *sagemaker.SageMaker {
+	return client.serviceConn("sagemakerconn", func() interface{} {
+		return sagemaker.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["sagemaker"])}))
+	}).(*sagemaker.SageMaker)
+}
+
+func (client *AWSClient) scconn() *servicecatalog.ServiceCatalog {
+	return client.serviceConn("scconn", func() interface{} {
+		return servicecatalog.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["servicecatalog"])}))
+	}).(*servicecatalog.ServiceCatalog)
+}
+
+func (client *AWSClient) sdconn() *servicediscovery.ServiceDiscovery {
+	return client.serviceConn("sdconn", func() interface{} {
+		return servicediscovery.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["servicediscovery"])}))
+	}).(*servicediscovery.ServiceDiscovery)
+}
+
+func (client *AWSClient) secretsmanagerconn() *secretsmanager.SecretsManager {
+	return client.serviceConn("secretsmanagerconn", func() interface{} {
+		return secretsmanager.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["secretsmanager"])}))
+	}).(*secretsmanager.SecretsManager)
+}
+
+func (client *AWSClient) securityhubconn() *securityhub.SecurityHub {
+	return client.serviceConn("securityhubconn", func() interface{} {
+		return securityhub.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["securityhub"])}))
+	}).(*securityhub.SecurityHub)
+}
+
+func (client *AWSClient) serverlessapplicationrepositoryconn() *serverlessapplicationrepository.ServerlessApplicationRepository {
+	return client.serviceConn("serverlessapplicationrepositoryconn", func() interface{} {
+		return serverlessapplicationrepository.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["serverlessrepo"])}))
+	}).(*serverlessapplicationrepository.ServerlessApplicationRepository)
+}
+
+func (client *AWSClient) servicequotasconn() *servicequotas.ServiceQuotas {
+	return client.serviceConn("servicequotasconn", func() interface{} {
+		return servicequotas.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["servicequotas"])}))
+	}).(*servicequotas.ServiceQuotas)
+}
+
+func (client *AWSClient) sesconn() *ses.SES {
+	return client.serviceConn("sesconn", func() interface{} {
+		return ses.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ses"])}))
+	}).(*ses.SES)
+}
+
+func (client *AWSClient) sfnconn() *sfn.SFN {
+	return client.serviceConn("sfnconn", func() interface{} {
+		return sfn.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["stepfunctions"])}))
+	}).(*sfn.SFN)
+}
+
+func (client *AWSClient) shieldconn() *shield.Shield {
+	return client.serviceConn("shieldconn", func() interface{} {
+		config := &aws.Config{
+			Endpoint: aws.String(client.endpoints["shield"]),
+		}
+
+		// Force "global" service to correct region
+		if client.partition == endpoints.AwsPartitionID {
+			config.Region = aws.String(endpoints.UsEast1RegionID)
+		}
+
+		return shield.New(client.session.Copy(config))
+	}).(*shield.Shield)
+}
+
+func (client *AWSClient) signerconn() *signer.Signer {
+	return client.serviceConn("signerconn", func() interface{} {
+		return signer.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["signer"])}))
+	}).(*signer.Signer)
+}
+
+func (client *AWSClient) simpledbconn() *simpledb.SimpleDB {
+	return client.serviceConn("simpledbconn", func() interface{} {
+		return simpledb.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["sdb"])}))
+	}).(*simpledb.SimpleDB)
+}
+
+func (client *AWSClient) snsconn() *sns.SNS {
+	return client.serviceConn("snsconn", func() interface{} {
+		return sns.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["sns"])}))
+	}).(*sns.SNS)
+}
+
+func (client *AWSClient) sqsconn() *sqs.SQS {
+	return client.serviceConn("sqsconn", func() interface{} {
+		return sqs.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["sqs"])}))
+	}).(*sqs.SQS)
+}
+
+func (client *AWSClient) ssmconn() *ssm.SSM {
+	return client.serviceConn("ssmconn", func() interface{} {
+		return ssm.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ssm"])}))
+	}).(*ssm.SSM)
+}
+
+func (client *AWSClient) ssoadminconn() *ssoadmin.SSOAdmin {
+	return client.serviceConn("ssoadminconn", func() interface{} {
+		return ssoadmin.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["ssoadmin"])}))
+	}).(*ssoadmin.SSOAdmin)
+}
+
+func (client *AWSClient) storagegatewayconn() *storagegateway.StorageGateway {
+	return client.serviceConn("storagegatewayconn", func() interface{} {
+		return storagegateway.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["storagegateway"])}))
+	}).(*storagegateway.StorageGateway)
+}
+
+func (client *AWSClient) stsconn() *sts.STS {
+	return client.serviceConn("stsconn", func() interface{} {
+		return sts.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["sts"])}))
+	}).(*sts.STS)
+}
+
+func (client *AWSClient) swfconn() *swf.SWF {
+	return client.serviceConn("swfconn", func() interface{} {
+		return swf.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["swf"])}))
+	}).(*swf.SWF)
+}
+
+func (client *AWSClient) syntheticsconn() *synthetics.Synthetics {
+	return client.serviceConn("syntheticsconn", func() interface{} {
+		return synthetics.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["synthetics"])}))
+	}).(*synthetics.Synthetics)
+}
+
+func (client *AWSClient) timestreamwriteconn() *timestreamwrite.TimestreamWrite {
+	return client.serviceConn("timestreamwriteconn", func() interface{} {
+		return timestreamwrite.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["timestreamwrite"])}))
+	}).(*timestreamwrite.TimestreamWrite)
+}
+
+func (client *AWSClient) transferconn() *transfer.Transfer {
+	return client.serviceConn("transferconn", func() interface{} {
+		return transfer.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["transfer"])}))
+	}).(*transfer.Transfer)
+}
+
+func (client *AWSClient) wafconn() *waf.WAF {
+	return client.serviceConn("wafconn", func() interface{} {
+		return waf.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["waf"])}))
+	}).(*waf.WAF)
+}
+
+func (client *AWSClient) wafregionalconn() *wafregional.WAFRegional {
+	return client.serviceConn("wafregionalconn", func() interface{} {
+		return wafregional.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["wafregional"])}))
+	}).(*wafregional.WAFRegional)
+}
+
+func (client *AWSClient) wafv2conn() *wafv2.WAFV2 {
+	return client.serviceConn("wafv2conn", func() interface{} {
+		return wafv2.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["wafv2"])}))
+	}).(*wafv2.WAFV2)
+}
+
+func (client *AWSClient) worklinkconn() *worklink.WorkLink {
+	return client.serviceConn("worklinkconn", func() interface{} {
+		return worklink.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["worklink"])}))
+	}).(*worklink.WorkLink)
+}
+
+func (client *AWSClient) workmailconn() *workmail.WorkMail {
+	return client.serviceConn("workmailconn", func() interface{} {
+		return workmail.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["workmail"])}))
+	}).(*workmail.WorkMail)
+}
+
+func (client *AWSClient) workspacesconn() *workspaces.WorkSpaces {
+	return client.serviceConn("workspacesconn", func() interface{} {
+		return workspaces.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["workspaces"])}))
+	}).(*workspaces.WorkSpaces)
+}
+
+func (client *AWSClient) xrayconn() *xray.XRay {
+	return client.serviceConn("xrayconn", func() interface{} {
+		return xray.New(client.session.Copy(&aws.Config{Endpoint: aws.String(client.endpoints["xray"])}))
+	}).(*xray.XRay)
+}
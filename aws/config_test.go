@@ -2,8 +2,12 @@ package aws
 
 import (
 	"reflect"
+	"sync"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
 )
@@ -82,6 +86,51 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestAWSClientServiceConn(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{
+		Region: aws.String("us-west-2"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &AWSClient{
+		conns:     make(map[string]interface{}),
+		endpoints: map[string]string{},
+		partition: endpoints.AwsPartitionID,
+		session:   sess,
+	}
+
+	if got, want := len(client.conns), 0; got != want {
+		t.Fatalf("expected %d service clients before first use, got %d", want, got)
+	}
+
+	var wg sync.WaitGroup
+	conns := make([]*ec2.EC2, 10)
+	for i := range conns {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			conns[i] = client.ec2conn()
+		}(i)
+	}
+	wg.Wait()
+
+	for i, conn := range conns {
+		if conn != conns[0] {
+			t.Errorf("service client %d: expected the same EC2 client on every call", i)
+		}
+	}
+
+	if got, want := len(client.conns), 1; got != want {
+		t.Errorf("expected %d service client after first use, got %d", want, got)
+	}
+
+	if got, want := aws.StringValue(client.r53conn().Config.Region), endpoints.UsEast1RegionID; got != want {
+		t.Errorf("expected Route 53 client region %q, got %q", want, got)
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*awsbase.MockEndpoint{
 		{
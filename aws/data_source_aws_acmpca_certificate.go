@@ -37,7 +37,7 @@ func dataSourceAwsAcmpcaCertificate() *schema.Resource {
 }
 
 func dataSourceAwsAcmpcaCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 	certificateArn := d.Get("arn").(string)
 
 	getCertificateInput := &acmpca.GetCertificateInput{
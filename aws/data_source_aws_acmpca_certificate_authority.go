@@ -95,7 +95,7 @@ func dataSourceAwsAcmpcaCertificateAuthority() *schema.Resource {
 }
 
 func dataSourceAwsAcmpcaCertificateAuthorityRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 	certificateAuthorityArn := d.Get("arn").(string)
 
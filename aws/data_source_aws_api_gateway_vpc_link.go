@@ -47,7 +47,7 @@ func dataSourceAwsApiGatewayVpcLink() *schema.Resource {
 }
 
 func dataSourceAwsApiGatewayVpcLinkRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayconn
+	conn := meta.(*AWSClient).apigatewayconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	params := &apigateway.GetVpcLinksInput{}
@@ -105,7 +105,7 @@ func dataSourceAwsApiGatewayV2Api() *schema.Resource {
 }
 
 func dataSourceAwsAwsApiGatewayV2ApiRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 	apiID := d.Get("api_id").(string)
 
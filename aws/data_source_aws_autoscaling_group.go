@@ -123,7 +123,7 @@ func dataSourceAwsAutoscalingGroup() *schema.Resource {
 }
 
 func dataSourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 
 	groupName := d.Get("name").(string)
 
@@ -72,7 +72,7 @@ func dataSourceAwsAvailabilityZone() *schema.Resource {
 }
 
 func dataSourceAwsAvailabilityZoneRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeAvailabilityZonesInput{}
 
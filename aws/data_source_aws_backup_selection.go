@@ -39,7 +39,7 @@ func dataSourceAwsBackupSelection() *schema.Resource {
 }
 
 func dataSourceAwsBackupSelectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.GetBackupSelectionInput{
 		BackupPlanId: aws.String(d.Get("plan_id").(string)),
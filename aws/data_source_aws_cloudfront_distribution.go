@@ -58,7 +58,7 @@ func dataSourceAwsCloudFrontDistribution() *schema.Resource {
 
 func dataSourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(d.Get("id").(string))
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	input := &cloudfront.GetDistributionInput{
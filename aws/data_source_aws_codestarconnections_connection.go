@@ -40,7 +40,7 @@ func dataSourceAwsCodeStarConnectionsConnection() *schema.Resource {
 }
 
 func dataSourceAwsCodeStarConnectionsConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codestarconnectionsconn
+	conn := meta.(*AWSClient).codestarconnectionsconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	arn := d.Get("arn").(string)
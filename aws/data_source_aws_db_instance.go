@@ -216,7 +216,7 @@ func dataSourceAwsDbInstance() *schema.Resource {
 }
 
 func dataSourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	opts := &rds.DescribeDBInstancesInput{
@@ -78,7 +78,7 @@ func TestAccAWSDocDBEngineVersionDataSource_defaultOnly(t *testing.T) {
 }
 
 func testAccAWSDocDBEngineVersionPreCheck(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).docdbconn
+	conn := testAccProvider.Meta().(*AWSClient).docdbconn()
 
 	input := &docdb.DescribeDBEngineVersionsInput{
 		Engine:      aws.String("docdb"),
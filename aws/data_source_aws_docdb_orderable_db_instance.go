@@ -61,7 +61,7 @@ func dataSourceAwsDocdbOrderableDbInstance() *schema.Resource {
 }
 
 func dataSourceAwsDocdbOrderableDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).docdbconn
+	conn := meta.(*AWSClient).docdbconn()
 
 	input := &docdb.DescribeOrderableDBInstanceOptionsInput{}
 
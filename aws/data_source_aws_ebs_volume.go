@@ -77,7 +77,7 @@ func dataSourceAwsEbsVolume() *schema.Resource {
 }
 
 func dataSourceAwsEbsVolumeRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	filters, filtersOk := d.GetOk("filter")
 
@@ -36,7 +36,7 @@ func dataSourceAwsEc2LocalGatewayVirtualInterfaceGroup() *schema.Resource {
 }
 
 func dataSourceAwsEc2LocalGatewayVirtualInterfaceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	input := &ec2.DescribeLocalGatewayVirtualInterfaceGroupsInput{}
@@ -28,7 +28,7 @@ func dataSourceAwsEc2LocalGateways() *schema.Resource {
 }
 
 func dataSourceAwsEc2LocalGatewaysRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeLocalGatewaysInput{}
 
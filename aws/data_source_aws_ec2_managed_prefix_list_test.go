@@ -13,7 +13,7 @@ import (
 
 func testAccDataSourceAwsEc2ManagedPrefixListGetIdByName(name string, id *string, arn *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		output, err := conn.DescribeManagedPrefixLists(&ec2.DescribeManagedPrefixListsInput{
 			Filters: []*ec2.Filter{
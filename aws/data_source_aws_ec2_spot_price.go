@@ -36,7 +36,7 @@ func dataSourceAwsEc2SpotPrice() *schema.Resource {
 }
 
 func dataSourceAwsEc2SpotPriceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	now := time.Now()
 	input := &ec2.DescribeSpotPriceHistoryInput{
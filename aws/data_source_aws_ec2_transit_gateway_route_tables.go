@@ -29,7 +29,7 @@ func dataSourceAwsEc2TransitGatewayRouteTables() *schema.Resource {
 }
 
 func dataSourceAwsEc2TransitGatewayRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeTransitGatewayRouteTablesInput{}
 
@@ -79,7 +79,7 @@ func dataSourceAwsEfsFileSystem() *schema.Resource {
 }
 
 func dataSourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) error {
-	efsconn := meta.(*AWSClient).efsconn
+	efsconn := meta.(*AWSClient).efsconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	describeEfsOpts := &efs.DescribeFileSystemsInput{}
@@ -80,7 +80,7 @@ func dataSourceAwsEip() *schema.Resource {
 }
 
 func dataSourceAwsEipRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeAddressesInput{}
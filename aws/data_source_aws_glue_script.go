@@ -98,7 +98,7 @@ func dataSourceAwsGlueScript() *schema.Resource {
 }
 
 func dataSourceAwsGlueScriptRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	dagEdge := d.Get("dag_edge").([]interface{})
 	dagNode := d.Get("dag_node").([]interface{})
@@ -45,6 +45,7 @@ func dataSourceAwsIamPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"policy_validation": iamPolicyValidationSchema(),
 			"source_json": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -274,6 +275,11 @@ func dataSourceAwsIamPolicyDocumentRead(d *schema.ResourceData, meta interface{}
 	}
 	jsonString := string(jsonDoc)
 
+	client, _ := meta.(*AWSClient)
+	if err := reportIAMPolicyFindings("aws_iam_policy_document", iamPolicyValidationMode(d.Get("policy_validation").(string), client), lintIAMPolicyDoc(mergedDoc)); err != nil {
+		return err
+	}
+
 	d.Set("json", jsonString)
 	d.SetId(strconv.Itoa(hashcode.String(jsonString)))
 
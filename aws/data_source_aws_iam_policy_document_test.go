@@ -96,6 +96,26 @@ func TestAccAWSDataSourceIAMPolicyDocument_sourceConflicting(t *testing.T) {
 	})
 }
 
+func TestAccAWSDataSourceIAMPolicyDocument_policyValidation(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, iam.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyDocumentPolicyValidationConfig("error"),
+				ExpectError: regexp.MustCompile(`unknown condition operator \(StringEqual\), did you mean StringEquals\?`),
+			},
+			{
+				Config: testAccAWSIAMPolicyDocumentPolicyValidationConfig("warn"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.aws_iam_policy_document.test", "json"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSDataSourceIAMPolicyDocument_sourceListConflicting(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:   func() { testAccPreCheck(t) },
@@ -1258,3 +1278,22 @@ func testAccAWSIAMPolicyDocumentExpectedJSONStatementPrincipalIdentifiersMultipl
   ]
 }`, testAccGetPartition())
 }
+
+func testAccAWSIAMPolicyDocumentPolicyValidationConfig(policyValidation string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  policy_validation = %[1]q
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::example/*"]
+
+    condition {
+      test     = "StringEqual"
+      variable = "aws:PrincipalOrgID"
+      values   = ["o-example"]
+    }
+  }
+}
+`, policyValidation)
+}
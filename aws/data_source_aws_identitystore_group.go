@@ -60,7 +60,7 @@ func dataSourceAwsIdentityStoreGroup() *schema.Resource {
 }
 
 func dataSourceAwsIdentityStoreGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).identitystoreconn
+	conn := meta.(*AWSClient).identitystoreconn()
 
 	input := &identitystore.ListGroupsInput{
 		IdentityStoreId: aws.String(d.Get("identity_store_id").(string)),
@@ -117,7 +117,7 @@ func dataSourceAwsImageBuilderImage() *schema.Resource {
 }
 
 func dataSourceAwsImageBuilderImageRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).imagebuilderconn
+	conn := meta.(*AWSClient).imagebuilderconn()
 
 	input := &imagebuilder.GetImageInput{}
 
@@ -54,7 +54,7 @@ func dataSourceAwsInstances() *schema.Resource {
 }
 
 func dataSourceAwsInstancesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	filters, filtersOk := d.GetOk("filter")
 	tags, tagsOk := d.GetOk("instance_tags")
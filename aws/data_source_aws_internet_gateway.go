@@ -51,7 +51,7 @@ func dataSourceAwsInternetGateway() *schema.Resource {
 }
 
 func dataSourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeInternetGatewaysInput{}
@@ -69,7 +69,7 @@ func dataSourceAwsLakeFormationDataLakeSettings() *schema.Resource {
 }
 
 func dataSourceAwsLakeFormationDataLakeSettingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lakeformationconn
+	conn := meta.(*AWSClient).lakeformationconn()
 
 	input := &lakeformation.GetDataLakeSettingsInput{}
 
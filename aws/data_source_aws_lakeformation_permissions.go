@@ -164,7 +164,7 @@ func dataSourceAwsLakeFormationPermissions() *schema.Resource {
 }
 
 func dataSourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lakeformationconn
+	conn := meta.(*AWSClient).lakeformationconn()
 
 	input := &lakeformation.ListPermissionsInput{
 		Principal: &lakeformation.DataLakePrincipal{
@@ -41,7 +41,7 @@ func dataSourceAwsLambdaInvocation() *schema.Resource {
 }
 
 func dataSourceAwsLambdaInvocationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	functionName := d.Get("function_name").(string)
 	qualifier := d.Get("qualifier").(string)
@@ -87,7 +87,7 @@ func dataSourceAwsLexBot() *schema.Resource {
 }
 
 func dataSourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	botName := d.Get("name").(string)
 	resp, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
@@ -54,7 +54,7 @@ func dataSourceAwsLexBotAlias() *schema.Resource {
 }
 
 func dataSourceAwsLexBotAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	botName := d.Get("bot_name").(string)
 	botAliasName := d.Get("name").(string)
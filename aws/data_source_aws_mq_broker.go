@@ -6,6 +6,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func dataSourceAwsMqBroker() *schema.Resource {
@@ -250,10 +251,12 @@ func dataSourceAwsMqBroker() *schema.Resource {
 }
 
 func dataSourceAwsmQBrokerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).mqconn()
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
 	if brokerId, ok := d.GetOk("broker_id"); ok {
 		d.SetId(brokerId.(string))
 	} else {
-		conn := meta.(*AWSClient).mqconn
 		brokerName := d.Get("broker_name").(string)
 
 		input := &mq.ListBrokersInput{}
@@ -287,5 +290,25 @@ func dataSourceAwsmQBrokerRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return resourceAwsMqBrokerRead(d, meta)
+	output, err := conn.DescribeBroker(&mq.DescribeBrokerInput{
+		BrokerId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading MQ broker (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("empty response while reading MQ broker (%s)", d.Id())
+	}
+
+	if err := flattenAwsMqBrokerResource(d, conn, output); err != nil {
+		return err
+	}
+
+	if err := d.Set("tags", keyvaluetags.MqKeyValueTags(output.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
 }
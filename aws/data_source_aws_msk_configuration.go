@@ -47,7 +47,7 @@ func dataSourceAwsMskConfiguration() *schema.Resource {
 }
 
 func dataSourceAwsMskConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	listConfigurationsInput := &kafka.ListConfigurationsInput{}
 
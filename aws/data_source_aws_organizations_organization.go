@@ -142,7 +142,7 @@ func dataSourceAwsOrganizationsOrganization() *schema.Resource {
 }
 
 func dataSourceAwsOrganizationsOrganizationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).organizationsconn
+	conn := meta.(*AWSClient).organizationsconn()
 
 	org, err := conn.DescribeOrganization(&organizations.DescribeOrganizationInput{})
 	if err != nil {
@@ -28,7 +28,7 @@ func dataSourceAwsOutpostsOutpostInstanceTypes() *schema.Resource {
 }
 
 func dataSourceAwsOutpostsOutpostInstanceTypesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).outpostsconn
+	conn := meta.(*AWSClient).outpostsconn()
 
 	input := &outposts.GetOutpostInstanceTypesInput{
 		OutpostId: aws.String(d.Get("arn").(string)), // Accepts both ARN and ID; prefer ARN which is more common
@@ -48,7 +48,7 @@ func testAccCheckOutpostsOutpostsAttributes(dataSourceName string) resource.Test
 }
 
 func testAccPreCheckAWSOutpostsOutposts(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).outpostsconn
+	conn := testAccProvider.Meta().(*AWSClient).outpostsconn()
 
 	input := &outposts.ListOutpostsInput{}
 
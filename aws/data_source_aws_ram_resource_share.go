@@ -68,7 +68,7 @@ func dataSourceAwsRamResourceShare() *schema.Resource {
 }
 
 func dataSourceAwsRamResourceShareRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	name := d.Get("name").(string)
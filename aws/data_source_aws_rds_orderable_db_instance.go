@@ -175,7 +175,7 @@ func dataSourceAwsRdsOrderableDbInstance() *schema.Resource {
 }
 
 func dataSourceAwsRdsOrderableDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).rdsconn
+	conn := meta.(*AWSClient).rdsconn()
 
 	input := &rds.DescribeOrderableDBInstanceOptionsInput{}
 
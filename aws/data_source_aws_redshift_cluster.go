@@ -170,7 +170,7 @@ func dataSourceAwsRedshiftCluster() *schema.Resource {
 }
 
 func dataSourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).redshiftconn
+	conn := meta.(*AWSClient).redshiftconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	cluster := d.Get("cluster_identifier").(string)
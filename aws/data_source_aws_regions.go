@@ -30,7 +30,7 @@ func dataSourceAwsRegions() *schema.Resource {
 }
 
 func dataSourceAwsRegionsRead(d *schema.ResourceData, meta interface{}) error {
-	connection := meta.(*AWSClient).ec2conn
+	connection := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[DEBUG] Reading regions.")
 	request := &ec2.DescribeRegionsInput{}
@@ -35,7 +35,7 @@ func dataSourceAwsRouteTables() *schema.Resource {
 }
 
 func dataSourceAwsRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeRouteTablesInput{}
 
@@ -55,7 +55,7 @@ func dataSourceAwsS3Bucket() *schema.Resource {
 }
 
 func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket := d.Get("bucket").(string)
 
@@ -94,18 +94,18 @@ func dataSourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func bucketLocation(client *AWSClient, d *schema.ResourceData, bucket string) error {
-	region, err := s3manager.GetBucketRegionWithClient(context.Background(), client.s3conn, bucket, func(r *request.Request) {
+	region, err := s3manager.GetBucketRegionWithClient(context.Background(), client.s3conn(), bucket, func(r *request.Request) {
 		// By default, GetBucketRegion forces virtual host addressing, which
 		// is not compatible with many non-AWS implementations. Instead, pass
 		// the provider s3_force_path_style configuration, which defaults to
 		// false, but allows override.
-		r.Config.S3ForcePathStyle = client.s3conn.Config.S3ForcePathStyle
+		r.Config.S3ForcePathStyle = client.s3conn().Config.S3ForcePathStyle
 
 		// By default, GetBucketRegion uses anonymous credentials when doing
 		// a HEAD request to get the bucket region. This breaks in aws-cn regions
 		// when the account doesn't have an ICP license to host public content.
 		// Use the current credentials when getting the bucket region.
-		r.Config.Credentials = client.s3conn.Config.Credentials
+		r.Config.Credentials = client.s3conn().Config.Credentials
 	})
 	if err != nil {
 		return err
@@ -121,7 +121,7 @@ func bucketLocation(client *AWSClient, d *schema.ResourceData, bucket string) er
 		d.Set("hosted_zone_id", hostedZoneID)
 	}
 
-	_, websiteErr := client.s3conn.GetBucketWebsite(
+	_, websiteErr := client.s3conn().GetBucketWebsite(
 		&s3.GetBucketWebsiteInput{
 			Bucket: aws.String(bucket),
 		},
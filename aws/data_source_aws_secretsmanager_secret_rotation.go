@@ -45,7 +45,7 @@ func dataSourceAwsSecretsManagerSecretRotation() *schema.Resource {
 }
 
 func dataSourceAwsSecretsManagerSecretRotationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).secretsmanagerconn
+	conn := meta.(*AWSClient).secretsmanagerconn()
 	secretID := d.Get("secret_id").(string)
 
 	input := &secretsmanager.DescribeSecretInput{
@@ -84,7 +84,7 @@ func dataSourceAwsSignerSigningProfile() *schema.Resource {
 }
 
 func dataSourceAwsSignerSigningProfileRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).signerconn
+	conn := meta.(*AWSClient).signerconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	profileName := d.Get("name").(string)
@@ -98,7 +98,7 @@ func dataSourceAwsSubnet() *schema.Resource {
 }
 
 func dataSourceAwsSubnetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	req := &ec2.DescribeSubnetsInput{}
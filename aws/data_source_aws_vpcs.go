@@ -29,7 +29,7 @@ func dataSourceAwsVpcs() *schema.Resource {
 }
 
 func dataSourceAwsVpcsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeVpcsInput{}
 
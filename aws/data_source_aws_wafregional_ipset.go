@@ -22,7 +22,7 @@ func dataSourceAwsWafRegionalIpSet() *schema.Resource {
 }
 
 func dataSourceAWSWafRegionalIpSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafregionalconn
+	conn := meta.(*AWSClient).wafregionalconn()
 	name := d.Get("name").(string)
 
 	ipsets := make([]*waf.IPSetSummary, 0)
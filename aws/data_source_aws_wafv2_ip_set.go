@@ -48,7 +48,7 @@ func dataSourceAwsWafv2IPSet() *schema.Resource {
 }
 
 func dataSourceAwsWafv2IPSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafv2conn
+	conn := meta.(*AWSClient).wafv2conn()
 	name := d.Get("name").(string)
 
 	var foundIpSet *wafv2.IPSetSummary
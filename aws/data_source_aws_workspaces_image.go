@@ -42,7 +42,7 @@ func dataSourceAwsWorkspacesImage() *schema.Resource {
 }
 
 func dataSourceAwsWorkspacesImageRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).workspacesconn
+	conn := meta.(*AWSClient).workspacesconn()
 
 	imageID := d.Get("image_id").(string)
 	input := &workspaces.DescribeWorkspaceImagesInput{
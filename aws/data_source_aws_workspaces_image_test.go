@@ -58,7 +58,7 @@ func testAccCheckWorkspacesImageExists(n string, image *workspaces.WorkspaceImag
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).workspacesconn
+		conn := testAccProvider.Meta().(*AWSClient).workspacesconn()
 		resp, err := conn.DescribeWorkspaceImages(&workspaces.DescribeWorkspaceImagesInput{
 			ImageIds: []*string{aws.String(rs.Primary.ID)},
 		})
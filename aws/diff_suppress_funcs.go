@@ -21,19 +21,6 @@ func suppressEquivalentAwsPolicyDiffs(k, old, new string, d *schema.ResourceData
 	return equivalent
 }
 
-// suppressEquivalentTypeStringBoolean provides custom difference suppression for TypeString booleans
-// Some arguments require three values: true, false, and "" (unspecified), but
-// confusing behavior exists when converting bare true/false values with state.
-func suppressEquivalentTypeStringBoolean(k, old, new string, d *schema.ResourceData) bool {
-	if old == "false" && new == "0" {
-		return true
-	}
-	if old == "true" && new == "1" {
-		return true
-	}
-	return false
-}
-
 // suppressMissingOptionalConfigurationBlock handles configuration block attributes in the following scenario:
 //  * The resource schema includes an optional configuration block with defaults
 //  * The API response includes those defaults to refresh into the Terraform state
@@ -30,47 +30,6 @@ func TestSuppressEquivalentJsonDiffsWhitespaceAndNoWhitespace(t *testing.T) {
 	}
 }
 
-func TestSuppressEquivalentTypeStringBoolean(t *testing.T) {
-	testCases := []struct {
-		old        string
-		new        string
-		equivalent bool
-	}{
-		{
-			old:        "false",
-			new:        "0",
-			equivalent: true,
-		},
-		{
-			old:        "true",
-			new:        "1",
-			equivalent: true,
-		},
-		{
-			old:        "",
-			new:        "0",
-			equivalent: false,
-		},
-		{
-			old:        "",
-			new:        "1",
-			equivalent: false,
-		},
-	}
-
-	for i, tc := range testCases {
-		value := suppressEquivalentTypeStringBoolean("test_property", tc.old, tc.new, nil)
-
-		if tc.equivalent && !value {
-			t.Fatalf("expected test case %d to be equivalent", i)
-		}
-
-		if !tc.equivalent && value {
-			t.Fatalf("expected test case %d to not be equivalent", i)
-		}
-	}
-}
-
 func TestSuppressEquivalentJsonOrYamlDiffs(t *testing.T) {
 	testCases := []struct {
 		description string
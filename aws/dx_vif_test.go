@@ -11,7 +11,7 @@ import (
 
 func testAccCheckDxVirtualInterfaceExists(name string, vif *directconnect.VirtualInterface) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).dxconn
+		conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -41,7 +41,7 @@ func testAccCheckDxVirtualInterfaceExists(name string, vif *directconnect.Virtua
 }
 
 func testAccCheckDxVirtualInterfaceDestroy(s *terraform.State, t string) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != t {
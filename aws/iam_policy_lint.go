@@ -0,0 +1,564 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	IAMPolicyValidationOff   = "off"
+	IAMPolicyValidationWarn  = "warn"
+	IAMPolicyValidationError = "error"
+)
+
+// IAMPolicyValidation_Values returns all policy validation modes.
+func IAMPolicyValidation_Values() []string {
+	return []string{
+		IAMPolicyValidationOff,
+		IAMPolicyValidationWarn,
+		IAMPolicyValidationError,
+	}
+}
+
+// IAMPolicyFinding is a problem found by linting an IAM policy document.
+type IAMPolicyFinding struct {
+	// Statement is the 1-based index of the statement, or 0 for findings about the whole document.
+	Statement int
+	Sid       string
+	Message   string
+}
+
+func (f IAMPolicyFinding) String() string {
+	switch {
+	case f.Statement == 0:
+		return f.Message
+	case f.Sid != "":
+		return fmt.Sprintf("statement %d (%s): %s", f.Statement, f.Sid, f.Message)
+	default:
+		return fmt.Sprintf("statement %d: %s", f.Statement, f.Message)
+	}
+}
+
+// iamPolicyValidationExcludedResourceTypes have a policy argument which is not an IAM policy document.
+var iamPolicyValidationExcludedResourceTypes = map[string]bool{
+	"aws_ecr_lifecycle_policy":  true,
+	"aws_iot_policy":            true,
+	"aws_iot_policy_attachment": true,
+	"aws_ses_receipt_filter":    true,
+}
+
+// iamPolicyMaxSizes holds the maximum policy size, in characters excluding whitespace, by resource type.
+// Reference: https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html
+var iamPolicyMaxSizes = map[string]int{
+	"aws_iam_group_policy":             5120,
+	"aws_iam_policy":                   6144,
+	"aws_iam_role_policy":              10240,
+	"aws_iam_user_policy":              2048,
+	"aws_kms_external_key":             32768,
+	"aws_kms_key":                      32768,
+	"aws_s3_bucket":                    20480,
+	"aws_s3_bucket_policy":             20480,
+	"aws_secretsmanager_secret":        20480,
+	"aws_secretsmanager_secret_policy": 20480,
+	"aws_sns_topic":                    30720,
+	"aws_sns_topic_policy":             30720,
+}
+
+// iamPolicyServicePrefixes are the known service prefixes of IAM actions.
+// Reference: https://docs.aws.amazon.com/service-authorization/latest/reference/reference_policies_actions-resources-contextkeys.html
+var iamPolicyServicePrefixes = map[string]bool{}
+
+func init() {
+	for _, prefix := range []string{
+		"a4b", "access-analyzer", "account", "acm", "acm-pca", "airflow", "amplify", "amplifybackend", "apigateway",
+		"app-integrations", "appconfig", "appflow", "application-autoscaling", "application-cost-profiler",
+		"applicationinsights", "appmesh", "appmesh-preview", "apprunner", "appstream", "appsync", "aps", "arsenal",
+		"artifact", "athena", "auditmanager", "autoscaling", "autoscaling-plans", "aws-marketplace",
+		"aws-marketplace-management", "aws-portal", "awsconnector", "backup", "backup-storage", "batch", "billing",
+		"braket", "budgets", "cassandra", "ce", "chatbot", "chime", "cloud9", "clouddirectory", "cloudformation",
+		"cloudfront", "cloudhsm", "cloudsearch", "cloudshell", "cloudtrail", "cloudwatch", "codeartifact",
+		"codebuild", "codecommit", "codedeploy", "codeguru", "codeguru-profiler", "codeguru-reviewer",
+		"codepipeline", "codestar", "codestar-connections", "codestar-notifications", "cognito-identity",
+		"cognito-idp", "cognito-sync", "comprehend", "comprehendmedical", "compute-optimizer", "config", "connect",
+		"cur", "databrew", "dataexchange", "datapipeline", "datasync", "dax", "dbqms", "deepcomposer", "deeplens",
+		"deepracer", "detective", "devicefarm", "devops-guru", "directconnect", "discovery", "dlm", "dms", "ds",
+		"dynamodb", "ebs", "ec2", "ec2-instance-connect", "ec2messages", "ecr", "ecr-public", "ecs", "eks",
+		"elastic-inference", "elasticache", "elasticbeanstalk", "elasticfilesystem", "elasticloadbalancing",
+		"elasticmapreduce", "elastictranscoder", "emr-containers", "es", "events", "execute-api", "firehose", "fis",
+		"fms", "forecast", "frauddetector", "freertos", "fsx", "gamelift", "geo", "glacier", "globalaccelerator",
+		"glue", "grafana", "greengrass", "groundstation", "groundtruthlabeling", "guardduty", "health", "healthlake",
+		"honeycode", "iam", "identity-sync", "identitystore", "imagebuilder", "importexport", "inspector",
+		"iot", "iot1click", "iotanalytics", "iotdeviceadvisor", "iotevents", "iotfleethub", "iotsitewise",
+		"iotwireless", "iq", "iq-permission", "ivs", "kafka", "kafka-cluster", "kendra", "kinesis",
+		"kinesisanalytics", "kinesisvideo", "kms", "lakeformation", "lambda", "launchwizard", "lex",
+		"license-manager", "lightsail", "logs", "lookoutequipment", "lookoutmetrics", "lookoutvision",
+		"machinelearning", "macie", "macie2", "managedblockchain", "mechanicalturk", "mediaconnect", "mediaconvert",
+		"medialive", "mediapackage", "mediapackage-vod", "mediastore", "mediatailor", "memorydb", "mgh", "mgn",
+		"mobileanalytics", "mobilehub", "mobiletargeting", "monitron", "mq", "neptune-db", "network-firewall",
+		"networkmanager", "nimble", "opsworks", "opsworks-cm", "organizations", "outposts", "panorama",
+		"payments", "personalize", "pi", "polly", "pricing", "profile", "proton", "purchase-orders", "qldb",
+		"quicksight", "ram", "rds", "rds-data", "rds-db", "redshift", "redshift-data", "rekognition",
+		"resource-groups", "robomaker", "route53", "route53domains", "route53resolver", "s3", "s3-object-lambda",
+		"s3-outposts", "sagemaker", "savingsplans", "schemas", "sdb", "secretsmanager", "securityhub",
+		"serverlessrepo", "servicecatalog", "servicediscovery", "servicequotas", "ses", "shield", "signer", "sms",
+		"sms-voice", "snowball", "sns", "sqlworkbench", "sqs", "ssm", "ssm-contacts", "ssm-incidents",
+		"ssmmessages", "sso", "sso-directory", "states", "storagegateway", "sts", "sumerian", "support", "swf",
+		"synthetics", "tag", "tax", "textract", "timestream", "transcribe", "transfer", "translate",
+		"trustedadvisor", "waf", "waf-regional", "wafv2", "wam", "wellarchitected", "workdocs", "worklink",
+		"workmail", "workmailmessageflow", "workspaces", "xray",
+	} {
+		iamPolicyServicePrefixes[prefix] = true
+	}
+}
+
+// iamPolicyConditionOperators are the condition operators without the IfExists suffix and set operator prefixes.
+// Reference: https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html
+var iamPolicyConditionOperators = []string{
+	"ArnEquals", "ArnLike", "ArnNotEquals", "ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals", "DateGreaterThan", "DateGreaterThanEquals", "DateLessThan", "DateLessThanEquals", "DateNotEquals",
+	"IpAddress", "NotIpAddress",
+	"Null",
+	"NumericEquals", "NumericGreaterThan", "NumericGreaterThanEquals", "NumericLessThan", "NumericLessThanEquals", "NumericNotEquals",
+	"StringEquals", "StringEqualsIgnoreCase", "StringLike", "StringNotEquals", "StringNotEqualsIgnoreCase", "StringNotLike",
+}
+
+// iamPolicyConditionSetOperators qualify condition operators for multivalued context keys.
+var iamPolicyConditionSetOperators = []string{
+	"ForAllValues",
+	"ForAnyValue",
+}
+
+// iamPolicyReadActionPrefixes are the action name prefixes of actions which do not modify resources.
+var iamPolicyReadActionPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+	"View",
+}
+
+// lintIAMPolicyJSON parses a JSON policy document and returns its findings.
+// A maxSize of 0 disables the size check.
+func lintIAMPolicyJSON(policy string, maxSize int) []IAMPolicyFinding {
+	var findings []IAMPolicyFinding
+
+	doc, err := parseIAMPolicyDoc(policy)
+
+	if err != nil {
+		return append(findings, IAMPolicyFinding{Message: fmt.Sprintf("invalid policy document: %s", err)})
+	}
+
+	if maxSize > 0 {
+		var buf bytes.Buffer
+
+		// Whitespace does not count towards the quotas.
+		if err := json.Compact(&buf, []byte(policy)); err == nil && buf.Len() > maxSize {
+			findings = append(findings, IAMPolicyFinding{Message: fmt.Sprintf("policy size (%d characters) exceeds the quota of %d characters", buf.Len(), maxSize)})
+		}
+	}
+
+	return append(findings, lintIAMPolicyDoc(doc)...)
+}
+
+// lintIAMPolicyDoc returns the findings of a policy document.
+func lintIAMPolicyDoc(doc *IAMPolicyDoc) []IAMPolicyFinding {
+	var findings []IAMPolicyFinding
+
+	sids := make(map[string]int)
+
+	for i, statement := range doc.Statements {
+		n := i + 1
+
+		addFinding := func(format string, a ...interface{}) {
+			findings = append(findings, IAMPolicyFinding{
+				Statement: n,
+				Sid:       statement.Sid,
+				Message:   fmt.Sprintf(format, a...),
+			})
+		}
+
+		if statement.Sid != "" {
+			if first, ok := sids[statement.Sid]; ok {
+				addFinding("duplicate Sid, also used by statement %d", first)
+			} else {
+				sids[statement.Sid] = n
+			}
+		}
+
+		actions := iamPolicyStringList(statement.Actions)
+		notActions := iamPolicyStringList(statement.NotActions)
+
+		for _, action := range append(actions, notActions...) {
+			if msg := lintIAMPolicyAction(action); msg != "" {
+				addFinding("%s", msg)
+			}
+		}
+
+		for _, condition := range statement.Conditions {
+			if msg := lintIAMPolicyConditionOperator(condition.Test); msg != "" {
+				addFinding("%s", msg)
+			}
+		}
+
+		if statement.Effect == "Allow" && len(statement.NotPrincipals) > 0 {
+			addFinding("NotPrincipal with Allow grants access to every principal not listed, use Principal instead")
+		}
+
+		if statement.Effect != "Allow" || len(statement.Conditions) > 0 {
+			continue
+		}
+
+		var wildcardResource bool
+		for _, resource := range iamPolicyStringList(statement.Resources) {
+			if resource == "*" {
+				wildcardResource = true
+				break
+			}
+		}
+
+		if !wildcardResource {
+			continue
+		}
+
+		var writeActions []string
+
+		if len(notActions) > 0 {
+			// NotAction with Allow grants every other action, including write actions.
+			writeActions = append(writeActions, "NotAction")
+		}
+
+		for _, action := range actions {
+			if iamPolicyIsWriteAction(action) {
+				writeActions = append(writeActions, action)
+			}
+		}
+
+		if len(writeActions) > 0 {
+			addFinding(`write actions (%s) allowed on Resource "*" without a Condition`, strings.Join(writeActions, ", "))
+		}
+	}
+
+	return findings
+}
+
+// parseIAMPolicyDoc decodes a JSON policy document.
+// Unlike the IAMPolicyDoc model, policies may contain a single statement object instead of a list.
+func parseIAMPolicyDoc(policy string) (*IAMPolicyDoc, error) {
+	var raw struct {
+		Version   string
+		Id        string
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, err
+	}
+
+	doc := &IAMPolicyDoc{
+		Version: raw.Version,
+		Id:      raw.Id,
+	}
+
+	statements := bytes.TrimSpace(raw.Statement)
+
+	if len(statements) == 0 {
+		return nil, fmt.Errorf("missing Statement")
+	}
+
+	if statements[0] == '{' {
+		statement := &IAMPolicyStatement{}
+
+		if err := json.Unmarshal(statements, statement); err != nil {
+			return nil, err
+		}
+
+		doc.Statements = []*IAMPolicyStatement{statement}
+
+		return doc, nil
+	}
+
+	if err := json.Unmarshal(statements, &doc.Statements); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+func lintIAMPolicyAction(action string) string {
+	if action == "*" {
+		return ""
+	}
+
+	parts := strings.SplitN(action, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Sprintf("action (%s) is not of the form service:action", action)
+	}
+
+	prefix := strings.ToLower(parts[0])
+
+	if iamPolicyServicePrefixes[prefix] {
+		return ""
+	}
+
+	var prefixes []string
+	for k := range iamPolicyServicePrefixes {
+		prefixes = append(prefixes, k)
+	}
+
+	if suggestion := closestString(prefix, prefixes); suggestion != "" {
+		return fmt.Sprintf("unknown service prefix (%s) in action %s, did you mean %s?", parts[0], action, suggestion)
+	}
+
+	return fmt.Sprintf("unknown service prefix (%s) in action %s", parts[0], action)
+}
+
+func lintIAMPolicyConditionOperator(operator string) string {
+	op := operator
+
+	if parts := strings.SplitN(op, ":", 2); len(parts) == 2 {
+		var known bool
+		for _, setOperator := range iamPolicyConditionSetOperators {
+			if parts[0] == setOperator {
+				known = true
+				break
+			}
+		}
+
+		if !known {
+			if suggestion := closestString(parts[0], iamPolicyConditionSetOperators); suggestion != "" {
+				return fmt.Sprintf("unknown condition set operator (%s) in %s, did you mean %s?", parts[0], operator, suggestion)
+			}
+
+			return fmt.Sprintf("unknown condition set operator (%s) in %s", parts[0], operator)
+		}
+
+		op = parts[1]
+	}
+
+	// Null has no IfExists form.
+	if v := strings.TrimSuffix(op, "IfExists"); v != op && v != "Null" {
+		op = v
+	}
+
+	for _, known := range iamPolicyConditionOperators {
+		if op == known {
+			return ""
+		}
+	}
+
+	if suggestion := closestString(op, iamPolicyConditionOperators); suggestion != "" {
+		return fmt.Sprintf("unknown condition operator (%s), did you mean %s?", operator, suggestion)
+	}
+
+	return fmt.Sprintf("unknown condition operator (%s)", operator)
+}
+
+// iamPolicyIsWriteAction reports whether an action, possibly containing wildcards, may modify resources.
+func iamPolicyIsWriteAction(action string) bool {
+	if action == "*" {
+		return true
+	}
+
+	parts := strings.SplitN(action, ":", 2)
+
+	if len(parts) != 2 {
+		return false
+	}
+
+	for _, prefix := range iamPolicyReadActionPrefixes {
+		if strings.HasPrefix(parts[1], prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// iamPolicyStringList returns the values of a policy element which is either a string or a list of strings.
+func iamPolicyStringList(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var l []string
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				l = append(l, s)
+			}
+		}
+		return l
+	}
+
+	return nil
+}
+
+// closestString returns the candidate within an edit distance of 2 of s (ignoring case),
+// or "" if there is none.
+func closestString(s string, candidates []string) string {
+	sorted := make([]string, len(candidates))
+	copy(sorted, candidates)
+	sort.Strings(sorted)
+
+	best, bestDistance := "", 3
+
+	for _, candidate := range sorted {
+		if d := levenshteinDistance(strings.ToLower(s), strings.ToLower(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+
+	return best
+}
+
+func levenshteinDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+
+	if c < a {
+		a = c
+	}
+
+	return a
+}
+
+// iamPolicyValidationSchema returns the schema of the policy_validation argument,
+// which overrides the provider policy_validation mode.
+func iamPolicyValidationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(IAMPolicyValidation_Values(), false),
+	}
+}
+
+// iamPolicyValidationMode returns the policy validation mode of a resource,
+// defaulting to the provider mode.
+func iamPolicyValidationMode(v string, client *AWSClient) string {
+	if v != "" {
+		return v
+	}
+
+	if client != nil && client.policyValidation != "" {
+		return client.policyValidation
+	}
+
+	return IAMPolicyValidationOff
+}
+
+// reportIAMPolicyFindings logs the findings in warn mode and returns them as an error in error mode.
+func reportIAMPolicyFindings(resourceName, mode string, findings []IAMPolicyFinding) error {
+	var errs *multierror.Error
+
+	for _, finding := range findings {
+		switch mode {
+		case IAMPolicyValidationWarn:
+			log.Printf("[WARN] %s policy: %s", resourceName, finding)
+		case IAMPolicyValidationError:
+			errs = multierror.Append(errs, fmt.Errorf("%s policy: %s", resourceName, finding))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// wrapResourcePolicyValidation adds a policy_validation argument to resources with an
+// IAM policy document policy argument and lints the planned policy.
+func wrapResourcePolicyValidation(name string, r *schema.Resource) {
+	if v, ok := r.Schema["policy"]; !ok || v.Type != schema.TypeString || !(v.Optional || v.Required) {
+		return
+	}
+
+	if iamPolicyValidationExcludedResourceTypes[name] {
+		return
+	}
+
+	if _, ok := r.Schema["policy_validation"]; ok {
+		return
+	}
+
+	r.Schema["policy_validation"] = iamPolicyValidationSchema()
+
+	customizeDiff := r.CustomizeDiff
+
+	r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		client, _ := meta.(*AWSClient)
+
+		if err := checkIAMPolicyValidation(name, diff, client); err != nil {
+			return err
+		}
+
+		if customizeDiff == nil {
+			return nil
+		}
+
+		return customizeDiff(ctx, diff, meta)
+	}
+}
+
+func checkIAMPolicyValidation(name string, diff *schema.ResourceDiff, client *AWSClient) error {
+	mode := iamPolicyValidationMode(diff.Get("policy_validation").(string), client)
+
+	if mode == IAMPolicyValidationOff || !diff.NewValueKnown("policy") {
+		return nil
+	}
+
+	policy := diff.Get("policy").(string)
+
+	if policy == "" {
+		return nil
+	}
+
+	resourceName := name
+	if diff.Id() != "" {
+		resourceName = fmt.Sprintf("%s (%s)", name, diff.Id())
+	}
+
+	return reportIAMPolicyFindings(resourceName, mode, lintIAMPolicyJSON(policy, iamPolicyMaxSizes[name]))
+}
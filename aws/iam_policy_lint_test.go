@@ -0,0 +1,182 @@
+package aws
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintIAMPolicyJSON(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Policy   string
+		MaxSize  int
+		Expected []string
+	}{
+		{
+			Name: "valid",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Read",
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:List*"],
+      "Resource": "*"
+    },
+    {
+      "Sid": "Write",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "arn:aws:s3:::example/*",
+      "Condition": {"ForAnyValue:StringEqualsIfExists": {"aws:TagKeys": ["a"]}, "Null": {"aws:RequestTag/a": "false"}}
+    }
+  ]
+}`,
+		},
+		{
+			Name:     "invalid JSON",
+			Policy:   `{"Statement": [`,
+			Expected: []string{"invalid policy document"},
+		},
+		{
+			Name:     "single statement object",
+			Policy:   `{"Statement": {"Effect": "Allow", "Action": "s3:DeleteObject", "Resource": "*"}}`,
+			Expected: []string{`statement 1: write actions (s3:DeleteObject) allowed on Resource "*" without a Condition`},
+		},
+		{
+			Name:     "unknown action prefix",
+			Policy:   `{"Statement": [{"Effect": "Deny", "Action": ["ec22:RunInstances", "foobarbaz:Get"], "Resource": "*"}]}`,
+			Expected: []string{"unknown service prefix (ec22) in action ec22:RunInstances, did you mean ec2?", "unknown service prefix (foobarbaz) in action foobarbaz:Get"},
+		},
+		{
+			Name:     "malformed action",
+			Policy:   `{"Statement": [{"Effect": "Deny", "NotAction": "s3", "Resource": "*"}]}`,
+			Expected: []string{"action (s3) is not of the form service:action"},
+		},
+		{
+			Name:     "condition operator typo",
+			Policy:   `{"Statement": [{"Effect": "Deny", "Action": "s3:*", "Resource": "*", "Condition": {"StringEqual": {"aws:PrincipalOrgID": "o-1"}}}]}`,
+			Expected: []string{"unknown condition operator (StringEqual), did you mean StringEquals?"},
+		},
+		{
+			Name:     "condition set operator typo",
+			Policy:   `{"Statement": [{"Effect": "Deny", "Action": "s3:*", "Resource": "*", "Condition": {"ForAllValue:StringLike": {"aws:TagKeys": "a*"}}}]}`,
+			Expected: []string{"unknown condition set operator (ForAllValue) in ForAllValue:StringLike, did you mean ForAllValues?"},
+		},
+		{
+			Name:     "NullIfExists",
+			Policy:   `{"Statement": [{"Effect": "Deny", "Action": "s3:*", "Resource": "*", "Condition": {"NullIfExists": {"aws:TagKeys": "true"}}}]}`,
+			Expected: []string{"unknown condition operator (NullIfExists)"},
+		},
+		{
+			Name:     "wildcard action and resource",
+			Policy:   `{"Statement": [{"Effect": "Allow", "Action": ["*"], "Resource": ["*"]}]}`,
+			Expected: []string{`write actions (*) allowed on Resource "*"`},
+		},
+		{
+			Name:     "NotAction with Allow",
+			Policy:   `{"Statement": [{"Effect": "Allow", "NotAction": "iam:*", "Resource": "*"}]}`,
+			Expected: []string{`write actions (NotAction) allowed on Resource "*"`},
+		},
+		{
+			Name:     "NotPrincipal with Allow",
+			Policy:   `{"Statement": [{"Sid": "Bad", "Effect": "Allow", "NotPrincipal": {"AWS": "arn:aws:iam::123456789012:root"}, "Action": "s3:GetObject", "Resource": "arn:aws:s3:::example/*"}]}`,
+			Expected: []string{"statement 1 (Bad): NotPrincipal with Allow"},
+		},
+		{
+			Name:   "NotPrincipal with Deny",
+			Policy: `{"Statement": [{"Effect": "Deny", "NotPrincipal": {"AWS": "arn:aws:iam::123456789012:root"}, "Action": "s3:*", "Resource": "*"}]}`,
+		},
+		{
+			Name:     "duplicate Sid",
+			Policy:   `{"Statement": [{"Sid": "A", "Effect": "Deny", "Action": "s3:*", "Resource": "*"}, {"Sid": "A", "Effect": "Deny", "Action": "sqs:*", "Resource": "*"}]}`,
+			Expected: []string{"statement 2 (A): duplicate Sid, also used by statement 1"},
+		},
+		{
+			Name:     "over size quota",
+			Policy:   `{"Statement": [{"Effect": "Deny", "Action": "s3:*", "Resource": "*"}]}`,
+			MaxSize:  32,
+			Expected: []string{"policy size (64 characters) exceeds the quota of 32 characters"},
+		},
+		{
+			Name:    "whitespace not counted towards size quota",
+			Policy:  "{\n  \"Statement\": [\n    {\"Effect\": \"Deny\", \"Action\": \"s3:*\", \"Resource\": \"*\"}\n  ]\n}",
+			MaxSize: 64,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			findings := lintIAMPolicyJSON(testCase.Policy, testCase.MaxSize)
+
+			if got, want := len(findings), len(testCase.Expected); got != want {
+				t.Fatalf("got %d findings (%v), want %d", got, findings, want)
+			}
+
+			for i, finding := range findings {
+				if !strings.Contains(finding.String(), testCase.Expected[i]) {
+					t.Errorf("finding %d: got %q, want it to contain %q", i, finding, testCase.Expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestIAMPolicyIsWriteAction(t *testing.T) {
+	testCases := []struct {
+		Action   string
+		Expected bool
+	}{
+		{"*", true},
+		{"s3:*", true},
+		{"s3:PutObject", true},
+		{"s3:*Object", true},
+		{"s3:Get*", false},
+		{"s3:GetObject", false},
+		{"dynamodb:BatchGetItem", false},
+		{"dynamodb:BatchWriteItem", true},
+		{"ec2:DescribeInstances", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := iamPolicyIsWriteAction(testCase.Action); got != testCase.Expected {
+			t.Errorf("iamPolicyIsWriteAction(%q) = %t, want %t", testCase.Action, got, testCase.Expected)
+		}
+	}
+}
+
+func TestReportIAMPolicyFindings(t *testing.T) {
+	findings := []IAMPolicyFinding{{Statement: 1, Message: "test"}}
+
+	for _, mode := range []string{IAMPolicyValidationOff, IAMPolicyValidationWarn} {
+		if err := reportIAMPolicyFindings("aws_iam_policy", mode, findings); err != nil {
+			t.Errorf("mode %s: unexpected error: %s", mode, err)
+		}
+	}
+
+	err := reportIAMPolicyFindings("aws_iam_policy", IAMPolicyValidationError, findings)
+
+	if err == nil {
+		t.Fatal("mode error: expected error")
+	}
+
+	if want := "aws_iam_policy policy: statement 1: test"; !strings.Contains(err.Error(), want) {
+		t.Errorf("got error %q, want it to contain %q", err, want)
+	}
+}
+
+func TestIAMPolicyValidationMode(t *testing.T) {
+	if got := iamPolicyValidationMode("", nil); got != IAMPolicyValidationOff {
+		t.Errorf("got %q, want %q", got, IAMPolicyValidationOff)
+	}
+
+	client := &AWSClient{policyValidation: IAMPolicyValidationWarn}
+
+	if got := iamPolicyValidationMode("", client); got != IAMPolicyValidationWarn {
+		t.Errorf("got %q, want %q", got, IAMPolicyValidationWarn)
+	}
+
+	if got := iamPolicyValidationMode(IAMPolicyValidationOff, client); got != IAMPolicyValidationOff {
+		t.Errorf("got %q, want %q", got, IAMPolicyValidationOff)
+	}
+}
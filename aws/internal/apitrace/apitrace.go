@@ -0,0 +1,320 @@
+package apitrace
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	EventTypeAPICall  = "api_call"
+	EventTypeResource = "resource"
+	EventTypeSummary  = "summary"
+)
+
+// APICallEvent records a completed AWS API request, including all of its retries.
+type APICallEvent struct {
+	Type       string    `json:"type"`
+	Time       time.Time `json:"time"`
+	Service    string    `json:"service"`
+	Operation  string    `json:"operation"`
+	Region     string    `json:"region"`
+	DurationMs int64     `json:"duration_ms"`
+	Retries    int       `json:"retries"`
+	Throttles  int       `json:"throttles"`
+	ErrorCode  string    `json:"error_code,omitempty"`
+	RequestID  string    `json:"request_id,omitempty"`
+}
+
+// ResourceEvent records a completed resource or data source operation.
+type ResourceEvent struct {
+	Type       string    `json:"type"`
+	Time       time.Time `json:"time"`
+	Resource   string    `json:"resource"`
+	ID         string    `json:"id,omitempty"`
+	Action     string    `json:"action"`
+	DurationMs int64     `json:"duration_ms"`
+	Error      bool      `json:"error,omitempty"`
+}
+
+// OperationSummary aggregates the API calls for a service operation.
+type OperationSummary struct {
+	Service         string         `json:"service"`
+	Operation       string         `json:"operation"`
+	Calls           int            `json:"calls"`
+	TotalDurationMs int64          `json:"total_duration_ms"`
+	Retries         int            `json:"retries"`
+	Throttles       int            `json:"throttles"`
+	Errors          map[string]int `json:"errors,omitempty"`
+}
+
+// ResourceSummary aggregates the operations for a resource type and action.
+type ResourceSummary struct {
+	Resource        string `json:"resource"`
+	Action          string `json:"action"`
+	Count           int    `json:"count"`
+	TotalDurationMs int64  `json:"total_duration_ms"`
+	MaxDurationMs   int64  `json:"max_duration_ms"`
+	MaxDurationID   string `json:"max_duration_id,omitempty"`
+	Errors          int    `json:"errors,omitempty"`
+}
+
+// SummaryEvent is written when the Tracer is closed.
+type SummaryEvent struct {
+	Type       string              `json:"type"`
+	Time       time.Time           `json:"time"`
+	APICalls   int                 `json:"api_calls"`
+	Throttles  int                 `json:"throttles"`
+	Operations []*OperationSummary `json:"operations"`
+	Resources  []*ResourceSummary  `json:"resources"`
+}
+
+// Tracer writes API call and resource events as JSON lines to a file.
+type Tracer struct {
+	lock       sync.Mutex
+	file       *os.File
+	encoder    *json.Encoder
+	throttles  map[*request.Request]int
+	operations map[string]*OperationSummary
+	resources  map[string]*ResourceSummary
+}
+
+// tracers holds the open Tracers so they can be closed on provider shutdown.
+var tracers = struct {
+	lock  sync.Mutex
+	store []*Tracer
+}{}
+
+// Open returns a Tracer appending to the file at path.
+func Open(path string) (*Tracer, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening API trace file (%s): %w", path, err)
+	}
+
+	t := &Tracer{
+		file:       file,
+		encoder:    json.NewEncoder(file),
+		throttles:  make(map[*request.Request]int),
+		operations: make(map[string]*OperationSummary),
+		resources:  make(map[string]*ResourceSummary),
+	}
+
+	tracers.lock.Lock()
+	tracers.store = append(tracers.store, t)
+	tracers.lock.Unlock()
+
+	return t, nil
+}
+
+// RetryHandler counts throttled attempts of a request.
+// It must be added to the Retry handlers of the session.
+func (t *Tracer) RetryHandler(r *request.Request) {
+	if !r.IsErrorThrottle() {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.throttles[r]++
+}
+
+// CompleteHandler records a request once it has completed.
+// It must be added to the Complete handlers of the session.
+func (t *Tracer) CompleteHandler(r *request.Request) {
+	event := &APICallEvent{
+		Type:       EventTypeAPICall,
+		Time:       time.Now(),
+		Service:    r.ClientInfo.ServiceName,
+		Region:     r.ClientInfo.SigningRegion,
+		DurationMs: time.Since(r.Time).Milliseconds(),
+		Retries:    r.RetryCount,
+		RequestID:  r.RequestID,
+	}
+
+	if r.Operation != nil {
+		event.Operation = r.Operation.Name
+	}
+
+	if awsErr, ok := r.Error.(awserr.Error); ok { // nolint:errorlint
+		event.ErrorCode = awsErr.Code()
+	} else if r.Error != nil {
+		event.ErrorCode = "Unknown"
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	event.Throttles = t.throttles[r]
+	delete(t.throttles, r)
+
+	key := event.Service + "." + event.Operation
+	summary, ok := t.operations[key]
+
+	if !ok {
+		summary = &OperationSummary{
+			Service:   event.Service,
+			Operation: event.Operation,
+		}
+		t.operations[key] = summary
+	}
+
+	summary.Calls++
+	summary.TotalDurationMs += event.DurationMs
+	summary.Retries += event.Retries
+	summary.Throttles += event.Throttles
+
+	if event.ErrorCode != "" {
+		if summary.Errors == nil {
+			summary.Errors = make(map[string]int)
+		}
+		summary.Errors[event.ErrorCode]++
+	}
+
+	t.write(event)
+}
+
+// RecordResource records a completed resource or data source operation.
+func (t *Tracer) RecordResource(resource, id, action string, duration time.Duration, err error) {
+	event := &ResourceEvent{
+		Type:       EventTypeResource,
+		Time:       time.Now(),
+		Resource:   resource,
+		ID:         id,
+		Action:     action,
+		DurationMs: duration.Milliseconds(),
+		Error:      err != nil,
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	key := resource + "." + action
+	summary, ok := t.resources[key]
+
+	if !ok {
+		summary = &ResourceSummary{
+			Resource: resource,
+			Action:   action,
+		}
+		t.resources[key] = summary
+	}
+
+	summary.Count++
+	summary.TotalDurationMs += event.DurationMs
+
+	if event.DurationMs >= summary.MaxDurationMs {
+		summary.MaxDurationMs = event.DurationMs
+		summary.MaxDurationID = id
+	}
+
+	if event.Error {
+		summary.Errors++
+	}
+
+	t.write(event)
+}
+
+// Summary returns the aggregated API calls and resource operations so far,
+// ordered by descending total duration.
+func (t *Tracer) Summary() *SummaryEvent {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	summary := &SummaryEvent{
+		Type: EventTypeSummary,
+		Time: time.Now(),
+	}
+
+	for _, v := range t.operations {
+		operation := *v
+		operation.Errors = make(map[string]int, len(v.Errors))
+
+		for code, count := range v.Errors {
+			operation.Errors[code] = count
+		}
+
+		summary.APICalls += operation.Calls
+		summary.Throttles += operation.Throttles
+		summary.Operations = append(summary.Operations, &operation)
+	}
+
+	for _, v := range t.resources {
+		resource := *v
+		summary.Resources = append(summary.Resources, &resource)
+	}
+
+	sort.Slice(summary.Operations, func(i, j int) bool {
+		return summary.Operations[i].TotalDurationMs > summary.Operations[j].TotalDurationMs
+	})
+
+	sort.Slice(summary.Resources, func(i, j int) bool {
+		return summary.Resources[i].TotalDurationMs > summary.Resources[j].TotalDurationMs
+	})
+
+	return summary
+}
+
+// Close writes the summary and closes the file.
+func (t *Tracer) Close() error {
+	tracers.lock.Lock()
+	for i, v := range tracers.store {
+		if v == t {
+			tracers.store = append(tracers.store[:i], tracers.store[i+1:]...)
+			break
+		}
+	}
+	tracers.lock.Unlock()
+
+	summary := t.Summary()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.file == nil {
+		return nil
+	}
+
+	log.Printf("[INFO] AWS API trace summary: %d API calls, %d throttled attempts", summary.APICalls, summary.Throttles)
+
+	t.write(summary)
+
+	err := t.file.Close()
+	t.file = nil
+
+	return err
+}
+
+// write must be called with the lock held.
+func (t *Tracer) write(event interface{}) {
+	if t.file == nil {
+		return
+	}
+
+	if err := t.encoder.Encode(event); err != nil {
+		log.Printf("[WARN] Error writing AWS API trace event: %s", err)
+	}
+}
+
+// CloseAll closes all open Tracers, writing their summaries.
+func CloseAll() {
+	tracers.lock.Lock()
+	store := make([]*Tracer, len(tracers.store))
+	copy(store, tracers.store)
+	tracers.lock.Unlock()
+
+	for _, t := range store {
+		if err := t.Close(); err != nil {
+			log.Printf("[WARN] Error closing AWS API trace file: %s", err)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package apitrace
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTracer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	tracer, err := Open(path)
+
+	if err != nil {
+		t.Fatalf("error opening tracer: %s", err)
+	}
+
+	r := &request.Request{
+		ClientInfo: metadata.ClientInfo{
+			ServiceName:   "route53",
+			SigningRegion: "us-east-1",
+		},
+		Operation:  &request.Operation{Name: "ChangeResourceRecordSets"},
+		Time:       time.Now().Add(-time.Second),
+		Error:      awserr.New("Throttling", "Rate exceeded", nil),
+		RetryCount: 2,
+	}
+
+	tracer.RetryHandler(r)
+	tracer.RetryHandler(r)
+	tracer.CompleteHandler(r)
+	tracer.RecordResource("aws_route53_record", "Z123_example.com_A", "create", 3*time.Second, nil)
+	tracer.RecordResource("aws_route53_record", "Z123_other.com_A", "create", time.Second, errors.New("test"))
+
+	if err := tracer.Close(); err != nil {
+		t.Fatalf("error closing tracer: %s", err)
+	}
+
+	file, err := os.Open(path)
+
+	if err != nil {
+		t.Fatalf("error opening trace file: %s", err)
+	}
+
+	defer file.Close()
+
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		var line map[string]interface{}
+
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("error parsing trace line (%s): %s", scanner.Text(), err)
+		}
+
+		lines = append(lines, line)
+	}
+
+	if got, want := len(lines), 4; got != want {
+		t.Fatalf("got %d trace lines, expected %d", got, want)
+	}
+
+	apiCall := lines[0]
+
+	if got, want := apiCall["type"], EventTypeAPICall; got != want {
+		t.Errorf("got type %v, expected %s", got, want)
+	}
+
+	if got, want := apiCall["operation"], "ChangeResourceRecordSets"; got != want {
+		t.Errorf("got operation %v, expected %s", got, want)
+	}
+
+	if got, want := apiCall["error_code"], "Throttling"; got != want {
+		t.Errorf("got error code %v, expected %s", got, want)
+	}
+
+	if got, want := apiCall["retries"], float64(2); got != want {
+		t.Errorf("got retries %v, expected %v", got, want)
+	}
+
+	if got, want := apiCall["throttles"], float64(2); got != want {
+		t.Errorf("got throttles %v, expected %v", got, want)
+	}
+
+	if got := apiCall["duration_ms"].(float64); got < 1000 {
+		t.Errorf("got duration %vms, expected at least 1000ms", got)
+	}
+
+	var summary SummaryEvent
+	line, _ := json.Marshal(lines[3])
+
+	if err := json.Unmarshal(line, &summary); err != nil {
+		t.Fatalf("error parsing summary: %s", err)
+	}
+
+	if got, want := summary.Type, EventTypeSummary; got != want {
+		t.Errorf("got summary type %s, expected %s", got, want)
+	}
+
+	if got, want := summary.APICalls, 1; got != want {
+		t.Errorf("got %d summary API calls, expected %d", got, want)
+	}
+
+	if got, want := len(summary.Resources), 1; got != want {
+		t.Fatalf("got %d summary resources, expected %d", got, want)
+	}
+
+	resource := summary.Resources[0]
+
+	if got, want := resource.Count, 2; got != want {
+		t.Errorf("got resource count %d, expected %d", got, want)
+	}
+
+	if got, want := resource.Errors, 1; got != want {
+		t.Errorf("got resource errors %d, expected %d", got, want)
+	}
+
+	if got, want := resource.MaxDurationID, "Z123_example.com_A"; got != want {
+		t.Errorf("got slowest resource %s, expected %s", got, want)
+	}
+}
+
+func TestWrapResource(t *testing.T) {
+	tracer, err := Open(filepath.Join(t.TempDir(), "trace.jsonl"))
+
+	if err != nil {
+		t.Fatalf("error opening tracer: %s", err)
+	}
+
+	defer tracer.Close()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("example")
+			return nil
+		},
+	}
+
+	WrapResource("aws_example", r, func(meta interface{}) *Tracer {
+		if meta == nil {
+			return nil
+		}
+
+		return tracer
+	})
+
+	if r.Read != nil {
+		t.Error("expected unset Read function to remain unset")
+	}
+
+	d := r.TestResourceData()
+
+	if err := r.Create(d, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(tracer.Summary().Resources), 0; got != want {
+		t.Fatalf("got %d traced resources without tracer, expected %d", got, want)
+	}
+
+	d = r.TestResourceData()
+
+	if err := r.Create(d, struct{}{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resources := tracer.Summary().Resources
+
+	if got, want := len(resources), 1; got != want {
+		t.Fatalf("got %d traced resources, expected %d", got, want)
+	}
+
+	if got, want := resources[0].MaxDurationID, "example"; got != want {
+		t.Errorf("got traced resource ID %s, expected %s", got, want)
+	}
+}
@@ -0,0 +1,97 @@
+package apitrace
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TracerFunc returns the Tracer for the provider meta, or nil if tracing is disabled.
+type TracerFunc func(meta interface{}) *Tracer
+
+// WrapResource records the duration of each create, read, update and delete
+// operation of the named resource or data source with the Tracer for the provider meta.
+// Terraform does not pass resource addresses to providers, so operations are
+// recorded by resource type and ID.
+func WrapResource(name string, r *schema.Resource, tracer TracerFunc) {
+	r.Create = wrapFunc(name, "create", r.Create, tracer)
+	r.Read = wrapFunc(name, "read", r.Read, tracer)
+	r.Update = wrapFunc(name, "update", r.Update, tracer)
+	r.Delete = wrapFunc(name, "delete", r.Delete, tracer)
+	r.CreateContext = wrapContextFunc(name, "create", r.CreateContext, tracer)
+	r.ReadContext = wrapContextFunc(name, "read", r.ReadContext, tracer)
+	r.UpdateContext = wrapContextFunc(name, "update", r.UpdateContext, tracer)
+	r.DeleteContext = wrapContextFunc(name, "delete", r.DeleteContext, tracer)
+}
+
+func wrapFunc(name, action string, f func(*schema.ResourceData, interface{}) error, tracer TracerFunc) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		t := tracer(meta)
+
+		if t == nil {
+			return f(d, meta)
+		}
+
+		id := d.Id()
+		start := time.Now()
+		err := f(d, meta)
+
+		if id == "" {
+			id = d.Id()
+		}
+
+		t.RecordResource(name, id, action, time.Since(start), err)
+
+		return err
+	}
+}
+
+func wrapContextFunc(name, action string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, tracer TracerFunc) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		t := tracer(meta)
+
+		if t == nil {
+			return f(ctx, d, meta)
+		}
+
+		id := d.Id()
+		start := time.Now()
+		diags := f(ctx, d, meta)
+
+		if id == "" {
+			id = d.Id()
+		}
+
+		var err error
+
+		if diags.HasError() {
+			err = diagsError(diags)
+		}
+
+		t.RecordResource(name, id, action, time.Since(start), err)
+
+		return diags
+	}
+}
+
+type diagsError diag.Diagnostics
+
+func (e diagsError) Error() string {
+	for _, d := range e {
+		if d.Severity == diag.Error {
+			return d.Summary
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,127 @@
+package credscache
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+// Key identifies the credentials resolved for a provider configuration.
+// Provider configurations with equal keys share credentials.
+type Key struct {
+	AccessKey                   string
+	AssumeRoleARN               string
+	AssumeRoleChain             string
+	AssumeRoleDurationSeconds   int
+	AssumeRoleExternalID        string
+	AssumeRolePolicy            string
+	AssumeRolePolicyARNs        string
+	AssumeRoleSessionName       string
+	AssumeRoleTags              string
+	AssumeRoleTransitiveTagKeys string
+	CredsFilename               string
+	Profile                     string
+	SecretKey                   string
+	SkipCredsValidation         bool
+	SkipRequestingAccountId     bool
+	StsEndpoint                 string
+	Token                       string
+}
+
+// String returns a representation of the key suitable for logging, without secrets.
+func (k Key) String() string {
+	return fmt.Sprintf("profile=%q role_arn=%q session_name=%q", k.Profile, k.AssumeRoleARN, k.AssumeRoleSessionName)
+}
+
+// JoinList returns a canonical string for a list of strings in a Key.
+func JoinList(l []string) string {
+	l = append([]string(nil), l...)
+	sort.Strings(l)
+
+	return strings.Join(l, ",")
+}
+
+// JoinMap returns a canonical string for a map of strings in a Key.
+func JoinMap(m map[string]string) string {
+	l := make([]string, 0, len(m))
+
+	for k, v := range m {
+		l = append(l, k+"="+v)
+	}
+
+	return JoinList(l)
+}
+
+// Entry holds resolved credentials and the account they belong to.
+type Entry struct {
+	// Credentials are shared by all provider configurations using the entry
+	// and refresh through their original provider, e.g. STS AssumeRole.
+	Credentials *credentials.Credentials
+
+	AccountID string
+	Partition string
+}
+
+// Resolver resolves credentials on a cache miss.
+type Resolver func() (*Entry, error)
+
+var (
+	entries = make(map[Key]*Entry)
+	locks   = make(map[Key]*sync.Mutex)
+	mu      sync.Mutex
+)
+
+// Load returns the unexpired cached entry for the key, or stores and returns the
+// entry from the resolver. Concurrent calls for the same key wait for a single
+// resolution. The boolean result reports whether the entry came from the cache.
+func Load(key Key, resolve Resolver) (*Entry, bool, error) {
+	mu.Lock()
+	lock, ok := locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		locks[key] = lock
+	}
+	mu.Unlock()
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	mu.Lock()
+	entry, ok := entries[key]
+	mu.Unlock()
+
+	if ok && !entry.Credentials.IsExpired() {
+		log.Printf("[DEBUG] Using cached AWS credentials (%s)", key)
+		return entry, true, nil
+	}
+
+	entry, err := resolve()
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Retrieve the credentials, if not already done, so that their expiry is known.
+	if _, err := entry.Credentials.Get(); err != nil {
+		return nil, false, err
+	}
+
+	mu.Lock()
+	entries[key] = entry
+	mu.Unlock()
+
+	return entry, false, nil
+}
+
+// Reset removes all cached entries.
+func Reset() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	entries = make(map[Key]*Entry)
+	locks = make(map[Key]*sync.Mutex)
+}
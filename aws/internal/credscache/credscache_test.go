@@ -0,0 +1,133 @@
+package credscache
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+func TestLoad(t *testing.T) {
+	Reset()
+
+	var calls int32
+	key := Key{Profile: "test", AssumeRoleARN: "arn:aws:iam::123456789012:role/test"}
+	resolve := func() (*Entry, error) {
+		atomic.AddInt32(&calls, 1)
+
+		return &Entry{
+			Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+			AccountID:   "123456789012",
+			Partition:   "aws",
+		}, nil
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			entry, _, err := Load(key, resolve)
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+
+			if got, want := entry.AccountID, "123456789012"; got != want {
+				t.Errorf("got account ID %q, want %q", got, want)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got, want := atomic.LoadInt32(&calls), int32(1); got != want {
+		t.Errorf("got %d resolutions, want %d", got, want)
+	}
+
+	_, cached, err := Load(key, resolve)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !cached {
+		t.Error("expected cached entry")
+	}
+
+	otherKey := key
+	otherKey.AssumeRoleSessionName = "other"
+
+	if _, cached, _ := Load(otherKey, resolve); cached {
+		t.Error("expected entry for other key not to be cached")
+	}
+}
+
+func TestLoadExpired(t *testing.T) {
+	Reset()
+
+	var calls int
+	key := Key{Profile: "test"}
+	resolve := func() (*Entry, error) {
+		calls++
+
+		return &Entry{Credentials: credentials.NewCredentials(expiredProvider{})}, nil
+	}
+
+	if _, _, err := Load(key, resolve); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, cached, _ := Load(key, resolve); cached {
+		t.Error("expected expired entry not to be used")
+	}
+
+	if got, want := calls, 2; got != want {
+		t.Errorf("got %d resolutions, want %d", got, want)
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	Reset()
+
+	key := Key{Profile: "test"}
+
+	if _, _, err := Load(key, func() (*Entry, error) { return nil, errors.New("test") }); err == nil {
+		t.Fatal("expected error")
+	}
+
+	_, cached, err := Load(key, func() (*Entry, error) {
+		return &Entry{Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "")}, nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if cached {
+		t.Error("expected error not to be cached")
+	}
+}
+
+func TestJoinMap(t *testing.T) {
+	if got, want := JoinMap(map[string]string{"b": "2", "a": "1"}), "a=1,b=2"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// expiredProvider returns credentials that have already expired.
+type expiredProvider struct{}
+
+func (expiredProvider) Retrieve() (credentials.Value, error) {
+	return credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, nil
+}
+
+func (expiredProvider) IsExpired() bool {
+	return true
+}
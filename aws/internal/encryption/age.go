@@ -0,0 +1,207 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Encryption to age X25519 recipients in the age v1 format, see https://age-encryption.org/v1.
+// The result can be decrypted with the age command line tool and the recipient's identity.
+
+const (
+	ageIntro              = "age-encryption.org/v1"
+	ageRecipientHRP       = "age"
+	ageX25519Label        = "age-encryption.org/v1/X25519"
+	ageFileKeySize        = 16
+	agePayloadNonceSize   = 16
+	agePayloadChunkSize   = 64 * 1024
+	ageStanzaColumnsLimit = 64
+)
+
+var ageBase64 = base64.RawStdEncoding
+
+// ageRecipient is an age X25519 recipient.
+type ageRecipient struct {
+	publicKey []byte
+	recipient string
+}
+
+// parseAgeRecipient parses a Bech32 encoded age X25519 recipient, e.g. age1...
+func parseAgeRecipient(s string) (*ageRecipient, error) {
+	hrp, publicKey, err := bech32Decode(s)
+
+	if err != nil {
+		return nil, fmt.Errorf("malformed age recipient %q: %w", s, err)
+	}
+
+	if hrp != ageRecipientHRP {
+		return nil, fmt.Errorf("malformed age recipient %q: invalid type %q", s, hrp)
+	}
+
+	if len(publicKey) != curve25519.PointSize {
+		return nil, fmt.Errorf("malformed age recipient %q: invalid public key length %d", s, len(publicKey))
+	}
+
+	return &ageRecipient{publicKey: publicKey, recipient: s}, nil
+}
+
+// Encrypt returns the recipient and the base64 encoded age encrypted value.
+func (r *ageRecipient) Encrypt(value []byte) (string, string, error) {
+	fileKey := make([]byte, ageFileKeySize)
+
+	if _, err := rand.Read(fileKey); err != nil {
+		return "", "", err
+	}
+
+	ephemeral := make([]byte, curve25519.ScalarSize)
+
+	if _, err := rand.Read(ephemeral); err != nil {
+		return "", "", err
+	}
+
+	ephemeralShare, err := curve25519.X25519(ephemeral, curve25519.Basepoint)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	sharedSecret, err := curve25519.X25519(ephemeral, r.publicKey)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	salt := append(append([]byte{}, ephemeralShare...), r.publicKey...)
+	wrappingKey, err := ageHKDF(sharedSecret, salt, ageX25519Label)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	wrappedFileKey, err := ageSeal(wrappingKey, make([]byte, chacha20poly1305.NonceSize), fileKey)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	var out bytes.Buffer
+
+	fmt.Fprintf(&out, "%s\n", ageIntro)
+	fmt.Fprintf(&out, "-> X25519 %s\n", ageBase64.EncodeToString(ephemeralShare))
+	writeAgeStanzaBody(&out, wrappedFileKey)
+	out.WriteString("---")
+
+	macKey, err := ageHKDF(fileKey, nil, "header")
+
+	if err != nil {
+		return "", "", err
+	}
+
+	mac := hmac.New(sha256.New, macKey)
+	mac.Write(out.Bytes())
+	fmt.Fprintf(&out, " %s\n", ageBase64.EncodeToString(mac.Sum(nil)))
+
+	if err := writeAgePayload(&out, fileKey, value); err != nil {
+		return "", "", err
+	}
+
+	return r.recipient, base64.StdEncoding.EncodeToString(out.Bytes()), nil
+}
+
+// writeAgeStanzaBody writes the stanza body as base64 wrapped at 64 columns.
+// The last line is always shorter than 64 columns, and may be empty.
+func writeAgeStanzaBody(w *bytes.Buffer, body []byte) {
+	encoded := ageBase64.EncodeToString(body)
+
+	for len(encoded) >= ageStanzaColumnsLimit {
+		w.WriteString(encoded[:ageStanzaColumnsLimit] + "\n")
+		encoded = encoded[ageStanzaColumnsLimit:]
+	}
+
+	w.WriteString(encoded + "\n")
+}
+
+// writeAgePayload writes the payload nonce and the value encrypted with the STREAM construction.
+func writeAgePayload(w io.Writer, fileKey, value []byte) error {
+	nonce := make([]byte, agePayloadNonceSize)
+
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	payloadKey, err := ageHKDF(fileKey, nonce, "payload")
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(nonce); err != nil {
+		return err
+	}
+
+	aead, err := chacha20poly1305.New(payloadKey)
+
+	if err != nil {
+		return err
+	}
+
+	chunkNonce := make([]byte, chacha20poly1305.NonceSize)
+
+	for counter := uint64(0); ; counter++ {
+		chunk := value
+		last := true
+
+		if len(value) > agePayloadChunkSize {
+			chunk, last = value[:agePayloadChunkSize], false
+		}
+
+		// 11 byte big endian counter followed by the last chunk flag.
+		for i := 0; i < 8; i++ {
+			chunkNonce[10-i] = byte(counter >> (8 * uint(i)))
+		}
+
+		chunkNonce[11] = 0
+		if last {
+			chunkNonce[11] = 1
+		}
+
+		if _, err := w.Write(aead.Seal(nil, chunkNonce, chunk, nil)); err != nil {
+			return err
+		}
+
+		if last {
+			return nil
+		}
+
+		value = value[agePayloadChunkSize:]
+	}
+}
+
+func ageHKDF(secret, salt []byte, info string) ([]byte, error) {
+	key := make([]byte, chacha20poly1305.KeySize)
+
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, []byte(info)), key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func ageSeal(key, nonce, plaintext []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nil, nonce, plaintext, nil), nil
+}
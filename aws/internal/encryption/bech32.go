@@ -0,0 +1,143 @@
+package encryption
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Bech32 (BIP 173) encoding, as used by age for recipients and identities.
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+var bech32Generator = []uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+
+func bech32Polymod(values []byte) uint32 {
+	chk := uint32(1)
+
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= bech32Generator[i]
+			}
+		}
+	}
+
+	return chk
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	h := []byte(strings.ToLower(hrp))
+	result := make([]byte, 0, len(h)*2+1)
+
+	for _, c := range h {
+		result = append(result, c>>5)
+	}
+
+	result = append(result, 0)
+
+	for _, c := range h {
+		result = append(result, c&31)
+	}
+
+	return result
+}
+
+// convertBits regroups the bits of data from groups of fromBits to groups of toBits.
+func convertBits(data []byte, fromBits, toBits uint, pad bool) ([]byte, error) {
+	var result []byte
+	acc, bits := uint32(0), uint(0)
+	maxv := uint32(1)<<toBits - 1
+
+	for _, v := range data {
+		if uint32(v)>>fromBits != 0 {
+			return nil, fmt.Errorf("invalid data range: %d", v)
+		}
+
+		acc = acc<<fromBits | uint32(v)
+		bits += fromBits
+
+		for bits >= toBits {
+			bits -= toBits
+			result = append(result, byte(acc>>bits&maxv))
+		}
+	}
+
+	if pad {
+		if bits > 0 {
+			result = append(result, byte(acc<<(toBits-bits)&maxv))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
+		return nil, fmt.Errorf("invalid padding")
+	}
+
+	return result, nil
+}
+
+// bech32Encode encodes the data with the human readable part, in lowercase.
+func bech32Encode(hrp string, data []byte) (string, error) {
+	values, err := convertBits(data, 8, 5, true)
+
+	if err != nil {
+		return "", err
+	}
+
+	hrp = strings.ToLower(hrp)
+	polymod := bech32Polymod(append(append(bech32HRPExpand(hrp), values...), 0, 0, 0, 0, 0, 0)) ^ 1
+
+	var b strings.Builder
+
+	b.WriteString(hrp)
+	b.WriteByte('1')
+
+	for _, v := range values {
+		b.WriteByte(bech32Charset[v])
+	}
+
+	for i := 0; i < 6; i++ {
+		b.WriteByte(bech32Charset[(polymod>>uint(5*(5-i)))&31])
+	}
+
+	return b.String(), nil
+}
+
+// bech32Decode returns the human readable part, in lowercase, and the data of a bech32 string.
+func bech32Decode(s string) (string, []byte, error) {
+	if strings.ToLower(s) != s && strings.ToUpper(s) != s {
+		return "", nil, fmt.Errorf("mixed case")
+	}
+
+	s = strings.ToLower(s)
+	pos := strings.LastIndex(s, "1")
+
+	if pos < 1 || pos+7 > len(s) {
+		return "", nil, fmt.Errorf("invalid separator position")
+	}
+
+	hrp := s[:pos]
+	values := make([]byte, 0, len(s)-pos-1)
+
+	for _, c := range s[pos+1:] {
+		v := strings.IndexRune(bech32Charset, c)
+
+		if v == -1 {
+			return "", nil, fmt.Errorf("invalid character %q", c)
+		}
+
+		values = append(values, byte(v))
+	}
+
+	if bech32Polymod(append(bech32HRPExpand(hrp), values...)) != 1 {
+		return "", nil, fmt.Errorf("invalid checksum")
+	}
+
+	data, err := convertBits(values[:len(values)-6], 5, 8, false)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return hrp, data, nil
+}
@@ -5,17 +5,93 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/vault/helper/pgpkeys"
 )
 
+const (
+	// AgePrefix selects encryption to an age X25519 recipient, e.g. "age:age1...".
+	AgePrefix = "age:"
+	// KMSPrefix selects envelope encryption with a KMS key, e.g. "kms:arn:aws:kms:...".
+	KMSPrefix = "kms:"
+	// KeybasePrefix selects PGP encryption with the public key of a keybase user.
+	KeybasePrefix = "keybase:"
+)
+
+// Encrypter encrypts values. Encrypt returns an identifier of the key used to
+// encrypt the value, such as a PGP fingerprint, and the base64 encoded encrypted value.
+type Encrypter interface {
+	Encrypt(value []byte) (string, string, error)
+}
+
+// NewEncrypter returns the Encrypter for the key, selected by its prefix:
+// "age:" followed by an age recipient, "kms:" followed by a KMS key ARN, ID or alias,
+// or otherwise a PGP key or "keybase:" username. The KMS connection is only used for "kms:" keys.
+func NewEncrypter(key string, kmsconn kmsiface.KMSAPI) (Encrypter, error) {
+	key = strings.TrimSpace(key)
+
+	switch {
+	case strings.HasPrefix(key, AgePrefix):
+		return parseAgeRecipient(strings.TrimPrefix(key, AgePrefix))
+	case strings.HasPrefix(key, KMSPrefix):
+		keyID := strings.TrimPrefix(key, KMSPrefix)
+
+		if keyID == "" {
+			return nil, fmt.Errorf("KMS key must not be empty")
+		}
+
+		return &kmsEncrypter{conn: kmsconn, keyID: keyID}, nil
+	}
+
+	pgpKey, err := RetrieveGPGKey(key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pgpEncrypter{key: pgpKey}, nil
+}
+
+// Encrypt encrypts the value with the Encrypter for the key. Description
+// should be set such that errors return a meaningful user-facing response.
+func Encrypt(key, value, description string, kmsconn kmsiface.KMSAPI) (string, string, error) {
+	encrypter, err := NewEncrypter(key, kmsconn)
+
+	if err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: %w", description, err)
+	}
+
+	keyID, encryptedValue, err := encrypter.Encrypt([]byte(value))
+
+	if err != nil {
+		return "", "", fmt.Errorf("Error encrypting %s: %w", description, err)
+	}
+
+	return keyID, encryptedValue, nil
+}
+
+// pgpEncrypter encrypts values with a PGP public key.
+type pgpEncrypter struct {
+	key string
+}
+
+// Encrypt returns the PGP key fingerprint and the base64 encoded encrypted value.
+func (e *pgpEncrypter) Encrypt(value []byte) (string, string, error) {
+	fingerprints, encryptedValue, err := pgpkeys.EncryptShares([][]byte{value}, []string{e.key})
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return fingerprints[0], base64.StdEncoding.EncodeToString(encryptedValue[0]), nil
+}
+
 // RetrieveGPGKey returns the PGP key specified as the pgpKey parameter, or queries
 // the public key from the keybase service if the parameter is a keybase username
 // prefixed with the phrase "keybase:"
 func RetrieveGPGKey(pgpKey string) (string, error) {
-	const keybasePrefix = "keybase:"
-
 	encryptionKey := pgpKey
-	if strings.HasPrefix(pgpKey, keybasePrefix) {
+	if strings.HasPrefix(pgpKey, KeybasePrefix) {
 		publicKeys, err := pgpkeys.FetchKeybasePubkeys([]string{pgpKey})
 		if err != nil {
 			return "", fmt.Errorf("Error retrieving Public Key for %s: %w", pgpKey, err)
@@ -25,15 +101,3 @@ func RetrieveGPGKey(pgpKey string) (string, error) {
 
 	return encryptionKey, nil
 }
-
-// EncryptValue encrypts the given value with the given encryption key. Description
-// should be set such that errors return a meaningful user-facing response.
-func EncryptValue(encryptionKey, value, description string) (string, string, error) {
-	fingerprints, encryptedValue, err :=
-		pgpkeys.EncryptShares([][]byte{[]byte(value)}, []string{encryptionKey})
-	if err != nil {
-		return "", "", fmt.Errorf("Error encrypting %s: %w", description, err)
-	}
-
-	return fingerprints[0], base64.StdEncoding.EncodeToString(encryptedValue[0]), nil
-}
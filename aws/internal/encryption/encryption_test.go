@@ -0,0 +1,421 @@
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+)
+
+func TestBech32RoundTrip(t *testing.T) {
+	data := []byte{0x00, 0x01, 0x02, 0xfe, 0xff}
+
+	s, err := bech32Encode("test", data)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	hrp, decoded, err := bech32Decode(strings.ToUpper(s))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hrp != "test" {
+		t.Errorf("got hrp %q, expected %q", hrp, "test")
+	}
+
+	if !bytes.Equal(decoded, data) {
+		t.Errorf("got data %x, expected %x", decoded, data)
+	}
+
+	if _, _, err := bech32Decode(s[:len(s)-1] + "q"); err == nil {
+		t.Errorf("expected checksum error")
+	}
+}
+
+func TestNewEncrypter(t *testing.T) {
+	_, recipient := testAgeIdentity(t)
+
+	testCases := []struct {
+		Name        string
+		Key         string
+		Expected    Encrypter
+		ExpectError bool
+	}{
+		{
+			Name:     "age",
+			Key:      AgePrefix + recipient,
+			Expected: &ageRecipient{},
+		},
+		{
+			Name:        "age invalid recipient",
+			Key:         AgePrefix + "age1invalid",
+			ExpectError: true,
+		},
+		{
+			Name:     "kms",
+			Key:      KMSPrefix + "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			Expected: &kmsEncrypter{},
+		},
+		{
+			Name:        "kms empty",
+			Key:         KMSPrefix,
+			ExpectError: true,
+		},
+		{
+			Name:     "pgp",
+			Key:      "mQENBFXbjPUBCADjNjCUQwfxKL+RR2GA6pv/1K+zJZ8UWIF9S0lk7cVIEfJiprzzwiMwBS5cD0da",
+			Expected: &pgpEncrypter{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := NewEncrypter(testCase.Key, nil)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectError {
+				return
+			}
+
+			switch testCase.Expected.(type) {
+			case *ageRecipient:
+				if _, ok := got.(*ageRecipient); !ok {
+					t.Errorf("got %T, expected age encrypter", got)
+				}
+			case *kmsEncrypter:
+				if _, ok := got.(*kmsEncrypter); !ok {
+					t.Errorf("got %T, expected KMS encrypter", got)
+				}
+			case *pgpEncrypter:
+				if _, ok := got.(*pgpEncrypter); !ok {
+					t.Errorf("got %T, expected PGP encrypter", got)
+				}
+			}
+		})
+	}
+}
+
+func TestEncrypt_age(t *testing.T) {
+	identity, recipient := testAgeIdentity(t)
+
+	for _, value := range []string{"", "secret", strings.Repeat("s", agePayloadChunkSize), strings.Repeat("s", agePayloadChunkSize*2+1)} {
+		gotRecipient, encrypted, err := Encrypt(AgePrefix+recipient, value, "test value", nil)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if gotRecipient != recipient {
+			t.Errorf("got recipient %q, expected %q", gotRecipient, recipient)
+		}
+
+		decrypted := testAgeDecrypt(t, identity, encrypted)
+
+		if decrypted != value {
+			t.Errorf("got decrypted value of length %d, expected length %d", len(decrypted), len(value))
+		}
+	}
+}
+
+func TestEncrypt_kms(t *testing.T) {
+	keyARN := "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+	conn := &mockKMSConn{keyARN: keyARN}
+
+	keyID, encrypted, err := Encrypt(KMSPrefix+"alias/test", "secret", "test value", conn)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if keyID != keyARN {
+		t.Errorf("got key ID %q, expected %q", keyID, keyARN)
+	}
+
+	if conn.requestedKeyID != "alias/test" {
+		t.Errorf("got requested key ID %q, expected %q", conn.requestedKeyID, "alias/test")
+	}
+
+	b, err := base64.StdEncoding.DecodeString(encrypted)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var envelope KMSEnvelope
+
+	if err := json.Unmarshal(b, &envelope); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if envelope.Version != KMSEnvelopeVersion {
+		t.Errorf("got version %d, expected %d", envelope.Version, KMSEnvelopeVersion)
+	}
+
+	if envelope.KeyID != keyARN {
+		t.Errorf("got envelope key ID %q, expected %q", envelope.KeyID, keyARN)
+	}
+
+	if !bytes.Equal(envelope.EncryptedDataKey, conn.ciphertextBlob) {
+		t.Errorf("got encrypted data key %x, expected %x", envelope.EncryptedDataKey, conn.ciphertextBlob)
+	}
+
+	block, err := aes.NewCipher(conn.plaintext)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	gcm, err := cipher.NewGCM(block)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	decrypted, err := gcm.Open(nil, envelope.Nonce, envelope.Ciphertext, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if string(decrypted) != "secret" {
+		t.Errorf("got decrypted value %q, expected %q", decrypted, "secret")
+	}
+}
+
+func TestEncrypt_kmsNoConnection(t *testing.T) {
+	_, _, err := Encrypt(KMSPrefix+"alias/test", "secret", "test value", nil)
+
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+type mockKMSConn struct {
+	kmsiface.KMSAPI
+
+	keyARN         string
+	requestedKeyID string
+	plaintext      []byte
+	ciphertextBlob []byte
+}
+
+func (m *mockKMSConn) GenerateDataKey(input *kms.GenerateDataKeyInput) (*kms.GenerateDataKeyOutput, error) {
+	m.requestedKeyID = aws.StringValue(input.KeyId)
+	m.plaintext = make([]byte, 32)
+	m.ciphertextBlob = []byte("encrypted data key")
+
+	if _, err := rand.Read(m.plaintext); err != nil {
+		return nil, err
+	}
+
+	return &kms.GenerateDataKeyOutput{
+		CiphertextBlob: m.ciphertextBlob,
+		KeyId:          aws.String(m.keyARN),
+		Plaintext:      m.plaintext,
+	}, nil
+}
+
+// testAgeIdentity returns a new X25519 identity and its age recipient.
+func testAgeIdentity(t *testing.T) ([]byte, string) {
+	identity := make([]byte, curve25519.ScalarSize)
+
+	if _, err := rand.Read(identity); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	publicKey, err := curve25519.X25519(identity, curve25519.Basepoint)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	recipient, err := bech32Encode(ageRecipientHRP, publicKey)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return identity, recipient
+}
+
+// testAgeDecrypt decrypts a base64 encoded age file with a single X25519 stanza.
+func testAgeDecrypt(t *testing.T, identity []byte, encrypted string) string {
+	b, err := base64.StdEncoding.DecodeString(encrypted)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r := bufio.NewReader(bytes.NewReader(b))
+	var header bytes.Buffer
+
+	readLine := func() string {
+		line, err := r.ReadString('\n')
+
+		if err != nil {
+			t.Fatalf("unexpected error reading header: %s", err)
+		}
+
+		header.WriteString(line)
+
+		return strings.TrimSuffix(line, "\n")
+	}
+
+	if line := readLine(); line != ageIntro {
+		t.Fatalf("got intro %q, expected %q", line, ageIntro)
+	}
+
+	args := strings.Fields(readLine())
+
+	if len(args) != 3 || args[0] != "->" || args[1] != "X25519" {
+		t.Fatalf("got stanza %v, expected X25519 stanza", args)
+	}
+
+	ephemeralShare, err := ageBase64.DecodeString(args[2])
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var body string
+
+	for {
+		line := readLine()
+		body += line
+
+		if len(line) < ageStanzaColumnsLimit {
+			break
+		}
+	}
+
+	wrappedFileKey, err := ageBase64.DecodeString(body)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	macLine, err := r.ReadString('\n')
+
+	if err != nil || !strings.HasPrefix(macLine, "--- ") {
+		t.Fatalf("got MAC line %q, expected header MAC", macLine)
+	}
+
+	header.WriteString("---")
+
+	publicKey, _ := curve25519.X25519(identity, curve25519.Basepoint)
+	sharedSecret, err := curve25519.X25519(identity, ephemeralShare)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	wrappingKey, err := ageHKDF(sharedSecret, append(append([]byte{}, ephemeralShare...), publicKey...), ageX25519Label)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	aead, err := chacha20poly1305.New(wrappingKey)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	fileKey, err := aead.Open(nil, make([]byte, chacha20poly1305.NonceSize), wrappedFileKey, nil)
+
+	if err != nil {
+		t.Fatalf("error unwrapping file key: %s", err)
+	}
+
+	macKey, err := ageHKDF(fileKey, nil, "header")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	mac := hmac.New(sha256.New, macKey)
+	mac.Write(header.Bytes())
+
+	if got := strings.TrimSuffix(strings.TrimPrefix(macLine, "--- "), "\n"); got != ageBase64.EncodeToString(mac.Sum(nil)) {
+		t.Fatalf("header MAC mismatch")
+	}
+
+	nonce := make([]byte, agePayloadNonceSize)
+
+	if _, err := r.Read(nonce); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	payloadKey, err := ageHKDF(fileKey, nonce, "payload")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	aead, err = chacha20poly1305.New(payloadKey)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var payload bytes.Buffer
+	r.WriteTo(&payload)
+	ciphertext := payload.Bytes()
+	chunkNonce := make([]byte, chacha20poly1305.NonceSize)
+	var plaintext []byte
+
+	for counter := uint64(0); ; counter++ {
+		chunk := ciphertext
+		last := true
+
+		if len(ciphertext) > agePayloadChunkSize+aead.Overhead() {
+			chunk, last = ciphertext[:agePayloadChunkSize+aead.Overhead()], false
+		}
+
+		for i := 0; i < 8; i++ {
+			chunkNonce[10-i] = byte(counter >> (8 * uint(i)))
+		}
+
+		chunkNonce[11] = 0
+		if last {
+			chunkNonce[11] = 1
+		}
+
+		p, err := aead.Open(nil, chunkNonce, chunk, nil)
+
+		if err != nil {
+			t.Fatalf("error decrypting payload chunk %d: %s", counter, err)
+		}
+
+		plaintext = append(plaintext, p...)
+
+		if last {
+			return string(plaintext)
+		}
+
+		ciphertext = ciphertext[len(chunk):]
+	}
+}
@@ -0,0 +1,86 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+)
+
+// KMSEnvelopeVersion is the version of the KMSEnvelope format.
+const KMSEnvelopeVersion = 1
+
+// KMSEnvelope is a value encrypted with AES-256-GCM using a data key generated by KMS.
+// Encrypted values are the base64 encoded JSON of the envelope. To decrypt, decrypt
+// the data key with the KMS Decrypt API and open the ciphertext with the plaintext data key and nonce.
+type KMSEnvelope struct {
+	Version int `json:"version"`
+	// KeyID is the ARN of the KMS key that encrypted the data key.
+	KeyID string `json:"key_id"`
+	// EncryptedDataKey is the data key encrypted by KMS.
+	EncryptedDataKey []byte `json:"encrypted_data_key"`
+	Nonce            []byte `json:"nonce"`
+	Ciphertext       []byte `json:"ciphertext"`
+}
+
+// kmsEncrypter envelope encrypts values with data keys from a KMS key.
+type kmsEncrypter struct {
+	conn  kmsiface.KMSAPI
+	keyID string
+}
+
+// Encrypt returns the KMS key ARN and the base64 encoded JSON KMSEnvelope of the encrypted value.
+func (e *kmsEncrypter) Encrypt(value []byte) (string, string, error) {
+	if e.conn == nil {
+		return "", "", fmt.Errorf("KMS encryption with key (%s) is not available", e.keyID)
+	}
+
+	output, err := e.conn.GenerateDataKey(&kms.GenerateDataKeyInput{
+		KeyId:   aws.String(e.keyID),
+		KeySpec: aws.String(kms.DataKeySpecAes256),
+	})
+
+	if err != nil {
+		return "", "", fmt.Errorf("error generating data key with KMS key (%s): %w", e.keyID, err)
+	}
+
+	block, err := aes.NewCipher(output.Plaintext)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+
+	if _, err := rand.Read(nonce); err != nil {
+		return "", "", err
+	}
+
+	envelope := KMSEnvelope{
+		Version:          KMSEnvelopeVersion,
+		KeyID:            aws.StringValue(output.KeyId),
+		EncryptedDataKey: output.CiphertextBlob,
+		Nonce:            nonce,
+		Ciphertext:       gcm.Seal(nil, nonce, value, nil),
+	}
+
+	b, err := json.Marshal(envelope)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	return envelope.KeyID, base64.StdEncoding.EncodeToString(b), nil
+}
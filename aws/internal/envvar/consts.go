@@ -44,6 +44,15 @@ const (
 	// For tests requiring GitHub permissions
 	GithubToken = "GITHUB_TOKEN"
 
+	// For sweepers, reports the resources that would be deleted without deleting them
+	SweepDryRun = "SWEEP_DRY_RUN"
+
+	// For sweepers, the maximum number of concurrent deletions per sweeper
+	SweepParallelism = "SWEEP_PARALLELISM"
+
+	// For sweepers, path of the file to write the report of leaked resources to
+	SweepReportFile = "SWEEP_REPORT_FILE"
+
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	TfAccAssumeRoleArn = "TF_ACC_ASSUME_ROLE_ARN"
@@ -0,0 +1,74 @@
+package nullable
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TypeNullableBool = schema.TypeString
+)
+
+type Bool string
+
+func (b Bool) IsNull() bool {
+	return b == ""
+}
+
+func (b Bool) Value() (bool, bool, error) {
+	if b.IsNull() {
+		return false, true, nil
+	}
+
+	value, err := strconv.ParseBool(string(b))
+	if err != nil {
+		return false, false, err
+	}
+	return value, false, nil
+}
+
+// NewBool returns the nullable representation of a boolean API value, with nil as null.
+func NewBool(v *bool) Bool {
+	if v == nil {
+		return ""
+	}
+
+	return Bool(strconv.FormatBool(*v))
+}
+
+// ValidateTypeStringNullableBool provides custom error messaging for TypeString booleans
+// Some arguments require three values: true, false, and "" (unspecified).
+// This ValidateFunc returns a custom message since the message with
+// validation.StringInSlice([]string{"", "false", "true"}, false) is confusing:
+// to be one of [ false true], got 1
+func ValidateTypeStringNullableBool(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	for _, str := range []string{"", "0", "1", "false", "true"} {
+		if value == str {
+			return
+		}
+	}
+
+	es = append(es, fmt.Errorf("expected %s to be one of [\"\", false, true], got %s", k, value))
+	return
+}
+
+// DiffSuppressTypeStringNullableBool suppresses differences between equivalent
+// TypeString booleans, e.g. "1" in configuration and "true" in state.
+func DiffSuppressTypeStringNullableBool(k, old, new string, d *schema.ResourceData) bool {
+	o, oNull, oErr := Bool(old).Value()
+	n, nNull, nErr := Bool(new).Value()
+
+	if oErr != nil || nErr != nil {
+		return false
+	}
+
+	return oNull == nNull && o == n
+}
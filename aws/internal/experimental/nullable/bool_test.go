@@ -0,0 +1,164 @@
+package nullable
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestNullableBool(t *testing.T) {
+	cases := []struct {
+		val           string
+		expectedNull  bool
+		expectedValue bool
+		expectedErr   error
+	}{
+		{
+			val:           "true",
+			expectedNull:  false,
+			expectedValue: true,
+		},
+		{
+			val:           "false",
+			expectedNull:  false,
+			expectedValue: false,
+		},
+		{
+			val:           "",
+			expectedNull:  true,
+			expectedValue: false,
+		},
+		{
+			val:           "A",
+			expectedNull:  false,
+			expectedValue: false,
+			expectedErr:   strconv.ErrSyntax,
+		},
+	}
+
+	for i, tc := range cases {
+		v := Bool(tc.val)
+
+		if null := v.IsNull(); null != tc.expectedNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, null, tc.expectedNull)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %t, got %t", i, tc.expectedValue, value)
+		}
+		if null != tc.expectedNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectedNull, null)
+		}
+		if tc.expectedErr == nil && err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+		if tc.expectedErr != nil {
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected test case %d to have error matching \"%s\", got %s", i, tc.expectedErr, err)
+			}
+		}
+	}
+}
+
+func TestNewBool(t *testing.T) {
+	vTrue, vFalse := true, false
+
+	if got, want := NewBool(nil), Bool(""); got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+
+	if got, want := NewBool(&vTrue), Bool("true"); got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+
+	if got, want := NewBool(&vFalse), Bool("false"); got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+}
+
+func TestValidationBool(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "",
+			f:   ValidateTypeStringNullableBool,
+		},
+		{
+			val: "0",
+			f:   ValidateTypeStringNullableBool,
+		},
+		{
+			val: "1",
+			f:   ValidateTypeStringNullableBool,
+		},
+		{
+			val: "true",
+			f:   ValidateTypeStringNullableBool,
+		},
+		{
+			val: "false",
+			f:   ValidateTypeStringNullableBool,
+		},
+		{
+			val:         "invalid",
+			f:           ValidateTypeStringNullableBool,
+			expectedErr: regexp.MustCompile(`to be one of \["", false, true\]`),
+		},
+		{
+			val:         true,
+			f:           ValidateTypeStringNullableBool,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestDiffSuppressBool(t *testing.T) {
+	cases := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        "false",
+			new:        "0",
+			equivalent: true,
+		},
+		{
+			old:        "true",
+			new:        "1",
+			equivalent: true,
+		},
+		{
+			old:        "1",
+			new:        "true",
+			equivalent: true,
+		},
+		{
+			old:        "",
+			new:        "0",
+			equivalent: false,
+		},
+		{
+			old:        "",
+			new:        "1",
+			equivalent: false,
+		},
+		{
+			old:        "false",
+			new:        "",
+			equivalent: false,
+		},
+		{
+			old:        "true",
+			new:        "false",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if got := DiffSuppressTypeStringNullableBool("test_property", tc.old, tc.new, nil); got != tc.equivalent {
+			t.Fatalf("expected test case %d equivalence to be %t, got %t", i, tc.equivalent, got)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package nullable
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TypeNullableFloat = schema.TypeString
+)
+
+type Float string
+
+func (f Float) IsNull() bool {
+	return f == ""
+}
+
+func (f Float) Value() (float64, bool, error) {
+	if f.IsNull() {
+		return 0, true, nil
+	}
+
+	value, err := strconv.ParseFloat(string(f), 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return value, false, nil
+}
+
+// NewFloat returns the nullable representation of a floating point API value, with nil as null.
+func NewFloat(v *float64) Float {
+	if v == nil {
+		return ""
+	}
+
+	return Float(strconv.FormatFloat(*v, 'f', -1, 64))
+}
+
+// ValidateTypeStringNullableFloat provides custom error messaging for TypeString floats
+// Some arguments require a floating point value or an unspecified, empty field.
+func ValidateTypeStringNullableFloat(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == "" {
+		return
+	}
+
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		es = append(es, fmt.Errorf("%s: cannot parse '%s' as float: %w", k, value, err))
+	}
+
+	return
+}
+
+// ValidateTypeStringNullableFloatAtLeast provides custom error messaging for TypeString floats
+// Some arguments require a floating point value or an unspecified, empty field.
+func ValidateTypeStringNullableFloatAtLeast(min float64) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (ws []string, es []error) {
+		value, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			es = append(es, fmt.Errorf("%s: cannot parse '%s' as float: %w", k, value, err))
+			return
+		}
+
+		if v < min {
+			es = append(es, fmt.Errorf("expected %s to be at least (%f), got %f", k, min, v))
+		}
+
+		return
+	}
+}
+
+// DiffSuppressTypeStringNullableFloat suppresses differences between equivalent
+// TypeString floats, e.g. "1.0" in configuration and "1" in state.
+func DiffSuppressTypeStringNullableFloat(k, old, new string, d *schema.ResourceData) bool {
+	o, oNull, oErr := Float(old).Value()
+	n, nNull, nErr := Float(new).Value()
+
+	if oErr != nil || nErr != nil {
+		return false
+	}
+
+	return oNull == nNull && o == n
+}
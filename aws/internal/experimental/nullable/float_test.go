@@ -0,0 +1,165 @@
+package nullable
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestNullableFloat(t *testing.T) {
+	cases := []struct {
+		val           string
+		expectedNull  bool
+		expectedValue float64
+		expectedErr   error
+	}{
+		{
+			val:           "1",
+			expectedNull:  false,
+			expectedValue: 1,
+		},
+		{
+			val:           "42.5",
+			expectedNull:  false,
+			expectedValue: 42.5,
+		},
+		{
+			val:           "",
+			expectedNull:  true,
+			expectedValue: 0,
+		},
+		{
+			val:           "A",
+			expectedNull:  false,
+			expectedValue: 0,
+			expectedErr:   strconv.ErrSyntax,
+		},
+	}
+
+	for i, tc := range cases {
+		v := Float(tc.val)
+
+		if null := v.IsNull(); null != tc.expectedNull {
+			t.Fatalf("expected test case %d IsNull to return %t, got %t", i, null, tc.expectedNull)
+		}
+
+		value, null, err := v.Value()
+		if value != tc.expectedValue {
+			t.Fatalf("expected test case %d Value to be %f, got %f", i, tc.expectedValue, value)
+		}
+		if null != tc.expectedNull {
+			t.Fatalf("expected test case %d Value null flag to be %t, got %t", i, tc.expectedNull, null)
+		}
+		if tc.expectedErr == nil && err != nil {
+			t.Fatalf("expected test case %d to succeed, got error %s", i, err)
+		}
+		if tc.expectedErr != nil {
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected test case %d to have error matching \"%s\", got %s", i, tc.expectedErr, err)
+			}
+		}
+	}
+}
+
+func TestNewFloat(t *testing.T) {
+	v := 1.5
+
+	if got, want := NewFloat(nil), Float(""); got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+
+	if got, want := NewFloat(&v), Float("1.5"); got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+}
+
+func TestValidationFloat(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "",
+			f:   ValidateTypeStringNullableFloat,
+		},
+		{
+			val: "0",
+			f:   ValidateTypeStringNullableFloat,
+		},
+		{
+			val: "42.0",
+			f:   ValidateTypeStringNullableFloat,
+		},
+		{
+			val:         "threeve",
+			f:           ValidateTypeStringNullableFloat,
+			expectedErr: regexp.MustCompile(`[\w]+: cannot parse 'threeve' as float: .*`),
+		},
+		{
+			val:         1.0,
+			f:           ValidateTypeStringNullableFloat,
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestValidationFloatAtLeast(t *testing.T) {
+	runTestCases(t, []testCase{
+		{
+			val: "1.5",
+			f:   ValidateTypeStringNullableFloatAtLeast(1.5),
+		},
+		{
+			val: "1.5",
+			f:   ValidateTypeStringNullableFloatAtLeast(0),
+		},
+		{
+			val:         "1.5",
+			f:           ValidateTypeStringNullableFloatAtLeast(2),
+			expectedErr: regexp.MustCompile(`expected [\w]+ to be at least \(2\.0+\), got 1\.50*`),
+		},
+		{
+			val:         1.5,
+			f:           ValidateTypeStringNullableFloatAtLeast(2),
+			expectedErr: regexp.MustCompile(`expected type of [\w]+ to be string`),
+		},
+	})
+}
+
+func TestDiffSuppressFloat(t *testing.T) {
+	cases := []struct {
+		old        string
+		new        string
+		equivalent bool
+	}{
+		{
+			old:        "1",
+			new:        "1.0",
+			equivalent: true,
+		},
+		{
+			old:        "0",
+			new:        "0.00",
+			equivalent: true,
+		},
+		{
+			old:        "",
+			new:        "0",
+			equivalent: false,
+		},
+		{
+			old:        "1",
+			new:        "1.5",
+			equivalent: false,
+		},
+		{
+			old:        "1",
+			new:        "A",
+			equivalent: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if got := DiffSuppressTypeStringNullableFloat("test_property", tc.old, tc.new, nil); got != tc.equivalent {
+			t.Fatalf("expected test case %d equivalence to be %t, got %t", i, tc.equivalent, got)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 )
 
 // Semaphore can be used to limit concurrent executions. This can be used to work with resources with low quotas
+// Resources limit concurrent operations with the provider max_concurrent_operations, see the internal/semaphore package.
 type Semaphore chan struct{}
 
 // InitializeSemaphore initializes a semaphore with a default capacity or overrides it using an environment variable
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"go/ast"
 	"go/format"
+	"go/token"
 	"html/template"
 	"log"
 	"os"
@@ -22,9 +23,14 @@ const (
 )
 
 var (
-	functionNames = flag.String("function", "", "comma-separated list of API List functions; required")
-	paginatorName = flag.String("paginator", "NextToken", "name of the pagination token field")
-	packageName   = flag.String("package", "", "override package name for generated code")
+	functionNames       = flag.String("function", "", "comma-separated list of API List functions; required")
+	paginatorName       = flag.String("paginator", "NextToken", "name of the pagination token field on both input and output")
+	inputPaginatorName  = flag.String("input-paginator", "", "name of the input pagination token field; overrides -paginator")
+	outputPaginatorName = flag.String("output-paginator", "", "name of the output pagination token field; overrides -paginator")
+	truncatedName       = flag.String("truncated", "", "name of the output field indicating more results, e.g. IsTruncated; by default the output pagination token is used")
+	pageSizeName        = flag.String("page-size-field", "", "name of the input field holding the page size, e.g. MaxResults")
+	pageSize            = flag.Int("page-size", 0, "page size to request when the input page size field is not set; requires -page-size-field")
+	packageName         = flag.String("package", "", "override package name for generated code")
 )
 
 func usage() {
@@ -49,13 +55,26 @@ func main() {
 		flag.Usage()
 		os.Exit(2)
 	}
+	if (*pageSize == 0) != (*pageSizeName == "") {
+		log.Fatal("error: -page-size and -page-size-field must be set together")
+	}
 	sourcePackage := args[0]
 	functions := strings.Split(*functionNames, ",")
 	sort.Strings(functions)
 
 	g := Generator{
-		paginator: *paginatorName,
-		tmpl:      template.Must(template.New("function").Parse(functionTemplate)),
+		inputPaginator:  *paginatorName,
+		outputPaginator: *paginatorName,
+		truncated:       *truncatedName,
+		pageSizeField:   *pageSizeName,
+		pageSize:        *pageSize,
+		tmpl:            template.Must(template.New("function").Parse(functionTemplate)),
+	}
+	if *inputPaginatorName != "" {
+		g.inputPaginator = *inputPaginatorName
+	}
+	if *outputPaginatorName != "" {
+		g.outputPaginator = *outputPaginatorName
 	}
 	g.parsePackage(sourcePackage)
 
@@ -84,10 +103,14 @@ type HeaderInfo struct {
 }
 
 type Generator struct {
-	buf       bytes.Buffer
-	pkg       *Package
-	tmpl      *template.Template
-	paginator string
+	buf             bytes.Buffer
+	pkg             *Package
+	tmpl            *template.Template
+	inputPaginator  string
+	outputPaginator string
+	truncated       string
+	pageSizeField   string
+	pageSize        int
 }
 
 func (g *Generator) Printf(format string, args ...interface{}) {
@@ -139,11 +162,16 @@ func (g *Generator) addPackage(pkg *packages.Package) {
 }
 
 type FuncSpec struct {
-	Name       string
-	RecvType   string
-	ParamType  string
-	ResultType string
-	Paginator  string
+	Name            string
+	RecvType        string
+	ParamType       string
+	ResultType      string
+	InputPaginator  string
+	OutputPaginator string
+	Truncated       string
+	PageSizeField   string
+	PageSizeType    string
+	PageSize        int
 }
 
 func (g *Generator) generateFunction(functionName string) {
@@ -171,11 +199,19 @@ func (g *Generator) generateFunction(functionName string) {
 	}
 
 	funcSpec := FuncSpec{
-		Name:       function.Name.Name,
-		RecvType:   g.expandTypeField(function.Recv),
-		ParamType:  g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
-		ResultType: g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
-		Paginator:  g.paginator,
+		Name:            function.Name.Name,
+		RecvType:        g.expandTypeField(function.Recv),
+		ParamType:       g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
+		ResultType:      g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
+		InputPaginator:  g.inputPaginator,
+		OutputPaginator: g.outputPaginator,
+		Truncated:       g.truncated,
+		PageSizeField:   g.pageSizeField,
+	}
+
+	if g.pageSizeField != "" {
+		funcSpec.PageSizeType = g.pageSizeType(functionName+"Input", g.pageSizeField)
+		funcSpec.PageSize = g.pageSize
 	}
 
 	err := g.tmpl.Execute(&g.buf, funcSpec)
@@ -184,6 +220,47 @@ func (g *Generator) generateFunction(functionName string) {
 	}
 }
 
+// pageSizeType returns the type of the input page size field, either "int64" or "string".
+func (g *Generator) pageSizeType(structName, fieldName string) string {
+	for _, file := range g.pkg.files {
+		for _, decl := range file.file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || typeSpec.Name.Name != structName {
+					continue
+				}
+				structType, ok := typeSpec.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				for _, field := range structType.Fields.List {
+					for _, name := range field.Names {
+						if name.Name != fieldName {
+							continue
+						}
+						if star, ok := field.Type.(*ast.StarExpr); ok {
+							if ident, ok := star.X.(*ast.Ident); ok {
+								switch ident.Name {
+								case "int64", "string":
+									return ident.Name
+								}
+							}
+						}
+						log.Fatalf("error: page size field \"%s\" of %s has an unsupported type", fieldName, structName)
+					}
+				}
+			}
+		}
+	}
+
+	log.Fatalf("error: page size field \"%s\" of %s not found", fieldName, structName)
+	return ""
+}
+
 func (g *Generator) expandTypeField(field *ast.FieldList) string {
 	typeValue := field.List[0].Type
 	if star, ok := typeValue.(*ast.StarExpr); ok {
@@ -222,18 +299,32 @@ func {{ .Name }}Pages(conn {{ .RecvType }}, input {{ .ParamType }}, fn func({{ .
 }
 
 func {{ .Name }}PagesWithContext(ctx context.Context, conn {{ .RecvType }}, input {{ .ParamType }}, fn func({{ .ResultType }}, bool) bool) error {
+{{- if .PageSizeField }}
+	if input.{{ .PageSizeField }} == nil {
+{{- if eq .PageSizeType "string" }}
+		input.{{ .PageSizeField }} = aws.String("{{ .PageSize }}")
+{{- else }}
+		input.{{ .PageSizeField }} = aws.Int64({{ .PageSize }})
+{{- end }}
+	}
+
+{{ end }}
 	for {
 		output, err := conn.{{ .Name }}WithContext(ctx, input)
 		if err != nil {
 			return err
 		}
 
-		lastPage := aws.StringValue(output.{{ .Paginator }}) == ""
+{{ if .Truncated -}}
+		lastPage := !aws.BoolValue(output.{{ .Truncated }}) || aws.StringValue(output.{{ .OutputPaginator }}) == ""
+{{- else -}}
+		lastPage := aws.StringValue(output.{{ .OutputPaginator }}) == ""
+{{- end }}
 		if !fn(output, lastPage) || lastPage {
 			break
 		}
 
-		input.{{ .Paginator }} = output.{{ .Paginator }}
+		input.{{ .InputPaginator }} = output.{{ .OutputPaginator }}
 	}
 	return nil
 }
@@ -0,0 +1,611 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	finderOutputName = "../finder/finder_gen.go"
+	statusOutputName = "status_gen.go"
+	waiterOutputName = "waiter_gen.go"
+)
+
+var (
+	specFile = flag.String("spec", "", "path of the JSON spec file; required")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] -spec <spec-file> <source-package>\n\n")
+	fmt.Fprintf(os.Stderr, "\tRun in a waiter package. The finder functions are written to the sibling finder package.\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+// Spec is the declarative description of the resources to generate code for.
+type Spec struct {
+	Resources []ResourceSpec `json:"resources"`
+}
+
+// ResourceSpec describes how to find a resource and read its status.
+type ResourceSpec struct {
+	// Name of the resource, e.g. "Ledger".
+	Name string `json:"name"`
+	// Operation is the describe operation, e.g. "DescribeLedger".
+	Operation string `json:"operation"`
+	// IDField is the input field identifying the resource, either a string or a list of strings.
+	IDField string `json:"idField"`
+	// OutputField is the output field holding the resource, either a structure or a list of structures.
+	// Empty means the operation output is the resource.
+	OutputField string `json:"outputField"`
+	// StatusField is the resource field holding its status.
+	StatusField string `json:"statusField"`
+	// NotFoundErrorCodes are AWS error codes or SDK ErrCode constant names returned when the resource does not exist.
+	NotFoundErrorCodes []string `json:"notFoundErrorCodes"`
+	// DeletedStatuses are statuses, or SDK constant names, of resources that are returned but no longer exist.
+	DeletedStatuses []string `json:"deletedStatuses"`
+	// Waiters describe the status transitions to wait for.
+	Waiters []WaiterSpec `json:"waiters"`
+}
+
+// WaiterSpec describes a status transition.
+type WaiterSpec struct {
+	// Name is appended to the resource name, e.g. "Created" for "LedgerCreated".
+	Name string `json:"name"`
+	// Pending are statuses, or SDK constant names, while the transition is in progress.
+	Pending []string `json:"pending"`
+	// Target are statuses, or SDK constant names, when the transition is complete.
+	// Empty means waiting until the resource is not found.
+	Target []string `json:"target"`
+	// Timeout is a Go duration, e.g. "10m".
+	Timeout string `json:"timeout"`
+	// Delay is an optional Go duration to wait before the first status check.
+	Delay string `json:"delay"`
+	// MinTimeout is an optional Go duration of the minimum time between status checks.
+	MinTimeout string `json:"minTimeout"`
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	args := flag.Args()
+	if *specFile == "" || len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	sourcePackage := args[0]
+
+	spec, err := readSpec(*specFile)
+	if err != nil {
+		log.Fatalf("error reading spec: %s", err)
+	}
+
+	sdk := loadSDKPackage(sourcePackage)
+	waiterPackage := loadPackage(".")
+
+	if waiterPackage.Name != "waiter" {
+		log.Fatalf("error: must be run in a waiter package, not %q", waiterPackage.Name)
+	}
+
+	info := TemplateInfo{
+		Parameters:    strings.Join(os.Args[1:], " "),
+		SourcePackage: sourcePackage,
+		SDK:           sdk.name,
+		FinderPackage: path.Join(path.Dir(waiterPackage.PkgPath), "finder"),
+	}
+
+	for _, resourceSpec := range spec.Resources {
+		info.Resources = append(info.Resources, sdk.resource(resourceSpec))
+	}
+
+	for _, resource := range info.Resources {
+		if len(resource.NotFoundErrorCodes) > 0 {
+			info.NeedsErrorCodes = true
+		}
+
+		if resource.OutputList {
+			info.NeedsFmt = true
+		}
+
+		for _, waiter := range resource.Waiters {
+			if len(waiter.Target) > 0 || len(waiter.Pending) > 0 {
+				info.NeedsWaiters = true
+			}
+		}
+	}
+
+	writeTemplate(finderOutputName, finderTemplate, info)
+	writeTemplate(statusOutputName, statusTemplate, info)
+
+	if info.NeedsWaiters {
+		writeTemplate(waiterOutputName, waiterTemplate, info)
+	}
+}
+
+func readSpec(name string) (*Spec, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+
+	spec := &Spec{}
+	if err := decoder.Decode(spec); err != nil {
+		return nil, err
+	}
+
+	if len(spec.Resources) == 0 {
+		return nil, fmt.Errorf("no resources")
+	}
+
+	return spec, nil
+}
+
+type TemplateInfo struct {
+	Parameters      string
+	SourcePackage   string
+	SDK             string
+	FinderPackage   string
+	NeedsErrorCodes bool
+	NeedsFmt        bool
+	NeedsWaiters    bool
+	Resources       []Resource
+}
+
+type Resource struct {
+	Name               string
+	Operation          string
+	ClientType         string
+	InputType          string
+	ResourceType       string
+	IDField            string
+	IDList             bool
+	OutputField        string
+	OutputList         bool
+	StatusField        string
+	NotFoundErrorCodes []string
+	DeletedStatuses    []string
+	Waiters            []Waiter
+}
+
+type Waiter struct {
+	Name       string
+	Pending    []string
+	Target     []string
+	Timeout    string
+	Delay      string
+	MinTimeout string
+}
+
+func loadPackage(pattern string) *packages.Package {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedSyntax,
+	}
+	pkgs, err := packages.Load(cfg, pattern)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+	return pkgs[0]
+}
+
+// SDKPackage holds the declarations of an AWS SDK for Go service package.
+type SDKPackage struct {
+	name      string
+	client    string
+	constants map[string]bool
+	funcs     map[string]bool
+	structs   map[string]*ast.StructType
+}
+
+func loadSDKPackage(sourcePackage string) *SDKPackage {
+	pkg := loadPackage(sourcePackage)
+	sdk := &SDKPackage{
+		name:      pkg.Name,
+		constants: make(map[string]bool),
+		funcs:     make(map[string]bool),
+		structs:   make(map[string]*ast.StructType),
+	}
+
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil || len(decl.Recv.List) == 0 {
+					continue
+				}
+				if star, ok := decl.Recv.List[0].Type.(*ast.StarExpr); ok {
+					if ident, ok := star.X.(*ast.Ident); ok {
+						sdk.funcs[decl.Name.Name] = true
+						// The service client is the receiver of the API operations.
+						if strings.HasSuffix(decl.Name.Name, "WithContext") {
+							sdk.client = ident.Name
+						}
+					}
+				}
+			case *ast.GenDecl:
+				for _, s := range decl.Specs {
+					switch s := s.(type) {
+					case *ast.ValueSpec:
+						if decl.Tok == token.CONST {
+							for _, name := range s.Names {
+								sdk.constants[name.Name] = true
+							}
+						}
+					case *ast.TypeSpec:
+						if st, ok := s.Type.(*ast.StructType); ok {
+							sdk.structs[s.Name.Name] = st
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if sdk.client == "" {
+		log.Fatalf("error: no service client found in %s", sourcePackage)
+	}
+
+	return sdk
+}
+
+func (sdk *SDKPackage) resource(spec ResourceSpec) Resource {
+	if spec.Name == "" || spec.Operation == "" || spec.IDField == "" || spec.StatusField == "" {
+		log.Fatalf("error: resource %q: name, operation, idField and statusField are required", spec.Name)
+	}
+
+	if !sdk.funcs[spec.Operation] {
+		log.Fatalf("error: resource %q: operation %q not found", spec.Name, spec.Operation)
+	}
+
+	resource := Resource{
+		Name:        spec.Name,
+		Operation:   spec.Operation,
+		ClientType:  fmt.Sprintf("*%s.%s", sdk.name, sdk.client),
+		InputType:   fmt.Sprintf("%s.%sInput", sdk.name, spec.Operation),
+		IDField:     spec.IDField,
+		OutputField: spec.OutputField,
+		StatusField: spec.StatusField,
+	}
+
+	idType, ok := sdk.fieldType(spec.Operation+"Input", spec.IDField)
+	if !ok {
+		log.Fatalf("error: resource %q: input field %q not found", spec.Name, spec.IDField)
+	}
+	switch idType {
+	case "*string":
+		resource.IDList = false
+	case "[]*string":
+		resource.IDList = true
+	default:
+		log.Fatalf("error: resource %q: input field %q has unsupported type %s", spec.Name, spec.IDField, idType)
+	}
+
+	resourceTypeName := spec.Operation + "Output"
+	if spec.OutputField != "" {
+		outputType, ok := sdk.fieldType(resourceTypeName, spec.OutputField)
+		if !ok {
+			log.Fatalf("error: resource %q: output field %q not found", spec.Name, spec.OutputField)
+		}
+		if strings.HasPrefix(outputType, "[]") {
+			resource.OutputList = true
+			outputType = strings.TrimPrefix(outputType, "[]")
+		}
+		if !strings.HasPrefix(outputType, "*") {
+			log.Fatalf("error: resource %q: output field %q has unsupported type %s", spec.Name, spec.OutputField, outputType)
+		}
+		resourceTypeName = strings.TrimPrefix(outputType, "*")
+	}
+	resource.ResourceType = fmt.Sprintf("*%s.%s", sdk.name, resourceTypeName)
+
+	statusType, ok := sdk.fieldType(resourceTypeName, spec.StatusField)
+	if !ok || statusType != "*string" {
+		log.Fatalf("error: resource %q: status field %q of %s must be a string", spec.Name, spec.StatusField, resourceTypeName)
+	}
+
+	resource.NotFoundErrorCodes = sdk.values(spec.NotFoundErrorCodes)
+	resource.DeletedStatuses = sdk.values(spec.DeletedStatuses)
+
+	for _, waiterSpec := range spec.Waiters {
+		if waiterSpec.Name == "" || len(waiterSpec.Pending) == 0 {
+			log.Fatalf("error: resource %q: waiter name and pending are required", spec.Name)
+		}
+
+		resource.Waiters = append(resource.Waiters, Waiter{
+			Name:       waiterSpec.Name,
+			Pending:    sdk.values(waiterSpec.Pending),
+			Target:     sdk.values(waiterSpec.Target),
+			Timeout:    durationExpr(spec.Name, waiterSpec.Timeout, true),
+			Delay:      durationExpr(spec.Name, waiterSpec.Delay, false),
+			MinTimeout: durationExpr(spec.Name, waiterSpec.MinTimeout, false),
+		})
+	}
+
+	return resource
+}
+
+// fieldType returns the type of the named field of the named structure, e.g. "*string".
+func (sdk *SDKPackage) fieldType(structName, fieldName string) (string, bool) {
+	st, ok := sdk.structs[structName]
+	if !ok {
+		return "", false
+	}
+
+	for _, field := range st.Fields.List {
+		for _, name := range field.Names {
+			if name.Name == fieldName {
+				return typeString(field.Type), true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func typeString(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		return expr.Name
+	case *ast.StarExpr:
+		return "*" + typeString(expr.X)
+	case *ast.ArrayType:
+		return "[]" + typeString(expr.Elt)
+	case *ast.SelectorExpr:
+		return typeString(expr.X) + "." + expr.Sel.Name
+	}
+
+	return fmt.Sprintf("%T", expr)
+}
+
+// values returns Go expressions for the values, qualifying SDK constant names.
+func (sdk *SDKPackage) values(l []string) []string {
+	var values []string
+
+	for _, v := range l {
+		if sdk.constants[v] {
+			values = append(values, sdk.name+"."+v)
+		} else {
+			values = append(values, fmt.Sprintf("%q", v))
+		}
+	}
+
+	return values
+}
+
+// durationExpr returns a Go expression for the duration string.
+func durationExpr(name, s string, required bool) string {
+	if s == "" {
+		if required {
+			log.Fatalf("error: resource %q: waiter timeout is required", name)
+		}
+		return ""
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		log.Fatalf("error: resource %q: %s", name, err)
+	}
+
+	switch {
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	}
+
+	return fmt.Sprintf("%d * time.Millisecond", d/time.Millisecond)
+}
+
+func writeTemplate(name, text string, info TemplateInfo) {
+	var buf bytes.Buffer
+
+	tmpl := template.Must(template.New(name).Funcs(template.FuncMap{
+		"join": strings.Join,
+	}).Parse(text))
+
+	if err := tmpl.Execute(&buf, info); err != nil {
+		log.Fatalf("error executing template for %s: %s", name, err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		src = buf.Bytes()
+	}
+
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		log.Fatalf("error creating directory for %s: %s", name, err)
+	}
+
+	if err := os.WriteFile(name, src, 0644); err != nil {
+		log.Fatalf("error writing %s: %s", name, err)
+	}
+}
+
+const finderTemplate = `// Code generated by "aws/internal/generators/waiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package finder
+
+import (
+{{- if .NeedsFmt }}
+	"fmt"
+
+{{ end }}
+	"github.com/aws/aws-sdk-go/aws"
+	"{{ .SourcePackage }}"
+{{- if .NeedsErrorCodes }}
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+{{- end }}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+{{ range .Resources }}
+// {{ .Name }}ByID returns the {{ .Name }} corresponding to the specified identifier.
+// Returns NotFoundError if no {{ .Name }} is found.
+func {{ .Name }}ByID(conn {{ .ClientType }}, id string) ({{ .ResourceType }}, error) {
+	input := &{{ .InputType }}{
+{{- if .IDList }}
+		{{ .IDField }}: aws.StringSlice([]string{id}),
+{{- else }}
+		{{ .IDField }}: aws.String(id),
+{{- end }}
+	}
+
+	output, err := conn.{{ .Operation }}(input)
+{{ range .NotFoundErrorCodes }}
+	if tfawserr.ErrCodeEquals(err, {{ . }}) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+{{ end }}
+	if err != nil {
+		return nil, err
+	}
+{{ $result := "output" }}
+{{- if .OutputList }}
+	if output == nil || len(output.{{ .OutputField }}) == 0 || output.{{ .OutputField }}[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	if count := len(output.{{ .OutputField }}); count > 1 {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("Too many results: wanted 1, got %d", count),
+			LastRequest: input,
+		}
+	}
+{{ $result = printf "output.%s[0]" .OutputField }}
+{{- else if .OutputField }}
+	if output == nil || output.{{ .OutputField }} == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+{{ $result = printf "output.%s" .OutputField }}
+{{- else }}
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+{{ end }}
+{{- if .DeletedStatuses }}
+	switch status := aws.StringValue({{ $result }}.{{ .StatusField }}); status {
+	case {{ join .DeletedStatuses ", " }}:
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+{{ end }}
+	return {{ $result }}, nil
+}
+{{ end }}`
+
+const statusTemplate = `// Code generated by "aws/internal/generators/waiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"{{ .SourcePackage }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"{{ .FinderPackage }}"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+{{ range .Resources }}
+// {{ .Name }}Status fetches the {{ .Name }} and its status.
+// The {{ .Name }} is nil if it is not found.
+func {{ .Name }}Status(conn {{ .ClientType }}, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.{{ .Name }}ByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.{{ .StatusField }}), nil
+	}
+}
+{{ end }}`
+
+const waiterTemplate = `// Code generated by "aws/internal/generators/waiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package waiter
+
+import (
+	"time"
+
+	"{{ .SourcePackage }}"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+{{- range $resource := .Resources }}
+{{- range .Waiters }}
+	{{ $resource.Name }}{{ .Name }}Timeout = {{ .Timeout }}
+{{- end }}
+{{- end }}
+)
+{{ range $resource := .Resources }}
+{{- range .Waiters }}
+// {{ $resource.Name }}{{ .Name }} waits for a {{ $resource.Name }} to return {{ if .Target }}{{ join .Target " or " }}{{ else }}not found{{ end }}.
+func {{ $resource.Name }}{{ .Name }}(conn {{ $resource.ClientType }}, id string) ({{ $resource.ResourceType }}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ {{- join .Pending ", " -}} },
+		Target:  []string{ {{- join .Target ", " -}} },
+		Refresh: {{ $resource.Name }}Status(conn, id),
+		Timeout: {{ $resource.Name }}{{ .Name }}Timeout,
+{{- if .Delay }}
+		Delay: {{ .Delay }},
+{{- end }}
+{{- if .MinTimeout }}
+		MinTimeout: {{ .MinTimeout }},
+{{- end }}
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.({{ $resource.ResourceType }}); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+{{ end }}
+{{- end }}`
@@ -13,7 +13,9 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 )
 
@@ -388,6 +390,51 @@ func (tags KeyValueTags) Updated(newTags KeyValueTags) KeyValueTags {
 	return result
 }
 
+// Validate returns an error describing every tag not satisfying the service
+// tag constraints (see ServiceTagConstraints), including the number of tags.
+// Keys with the reserved aws: prefix are also invalid.
+func (tags KeyValueTags) Validate(serviceName string) error {
+	constraints := ServiceTagConstraints(serviceName)
+	var errs *multierror.Error
+
+	if n := len(tags); n > constraints.MaxTags {
+		errs = multierror.Append(errs, fmt.Errorf("number of tags (%d) exceeds the maximum (%d)", n, constraints.MaxTags))
+	}
+
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if strings.HasPrefix(strings.ToLower(k), AwsTagKeyPrefix) {
+			errs = multierror.Append(errs, fmt.Errorf("tag key (%s): %s prefix is reserved for AWS use", k, AwsTagKeyPrefix))
+		}
+
+		if n := utf8.RuneCountInString(k); n > constraints.MaxKeyLength {
+			errs = multierror.Append(errs, fmt.Errorf("tag key (%s): length (%d) exceeds the maximum (%d)", k, n, constraints.MaxKeyLength))
+		}
+
+		if constraints.AllowedCharacters != nil && !constraints.AllowedCharacters.MatchString(k) {
+			errs = multierror.Append(errs, fmt.Errorf("tag key (%s): contains characters not allowed", k))
+		}
+
+		v := tags.KeyValue(k)
+
+		if v == nil {
+			continue
+		}
+
+		if n := utf8.RuneCountInString(*v); n > constraints.MaxValueLength {
+			errs = multierror.Append(errs, fmt.Errorf("tag (%s) value: length (%d) exceeds the maximum (%d)", k, n, constraints.MaxValueLength))
+		}
+
+		if constraints.AllowedCharacters != nil && !constraints.AllowedCharacters.MatchString(*v) {
+			errs = multierror.Append(errs, fmt.Errorf("tag (%s) value: contains characters not allowed", k))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 // Chunks returns a slice of KeyValueTags, each of the specified size.
 func (tags KeyValueTags) Chunks(size int) []KeyValueTags {
 	result := []KeyValueTags{}
@@ -492,10 +539,12 @@ func (tags KeyValueTags) UrlEncode() string {
 }
 
 // New creates KeyValueTags from common Terraform Provider SDK types.
-// Supports map[string]string, map[string]*string, map[string]interface{}, and []interface{}.
+// Supports KeyValueTags, map[string]string, map[string]*string, map[string]interface{}, and []interface{}.
 // When passed []interface{}, all elements are treated as keys and assigned nil values.
 func New(i interface{}) KeyValueTags {
 	switch value := i.(type) {
+	case KeyValueTags:
+		return value
 	case map[string]*TagData:
 		kvtm := make(KeyValueTags, len(value))
 
@@ -1,6 +1,8 @@
 package keyvaluetags
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -1695,6 +1697,100 @@ func TestKeyValueTagsChunks(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsValidate(t *testing.T) {
+	manyTags := make(map[string]string)
+	for i := 0; i < 11; i++ {
+		manyTags[fmt.Sprintf("key%d", i)] = "value"
+	}
+
+	testCases := []struct {
+		name        string
+		tags        KeyValueTags
+		serviceName string
+		wantErrs    []string
+	}{
+		{
+			name:        "valid",
+			tags:        New(map[string]string{"Name": "test", "cost-center": "a/b:c@d+e=f"}),
+			serviceName: "s3",
+		},
+		{
+			name:        "too many tags",
+			tags:        New(manyTags),
+			serviceName: "s3object",
+			wantErrs:    []string{"number of tags (11) exceeds the maximum (10)"},
+		},
+		{
+			name:        "not too many tags",
+			tags:        New(manyTags),
+			serviceName: "s3",
+		},
+		{
+			name:        "lengths",
+			tags:        New(map[string]string{strings.Repeat("k", 129): "value", "key": strings.Repeat("v", 257)}),
+			serviceName: "s3",
+			wantErrs: []string{
+				"tag (key) value: length (257) exceeds the maximum (256)",
+				"tag key (" + strings.Repeat("k", 129) + "): length (129) exceeds the maximum (128)",
+			},
+		},
+		{
+			name:        "reserved prefix",
+			tags:        New(map[string]string{"AWS:key": "value"}),
+			serviceName: "s3",
+			wantErrs:    []string{"tag key (AWS:key): aws: prefix is reserved for AWS use"},
+		},
+		{
+			name:        "characters",
+			tags:        New(map[string]string{"key#1": "value!"}),
+			serviceName: "s3",
+			wantErrs: []string{
+				"tag key (key#1): contains characters not allowed",
+				"tag (key#1) value: contains characters not allowed",
+			},
+		},
+		{
+			name:        "all characters allowed",
+			tags:        New(map[string]string{"key#1": "value!"}),
+			serviceName: "ec2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.tags.Validate(testCase.serviceName)
+
+			if len(testCase.wantErrs) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+
+			for _, want := range testCase.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("expected error containing %q, got: %s", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceTagFunctionBatchSize(t *testing.T) {
+	if got, want := ServiceTagFunctionBatchSize("kinesis"), "10"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if got, want := ServiceTagFunctionBatchSize("s3"), ""; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
 func TestKeyValueTagsContainsAll(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -0,0 +1,201 @@
+package keyvaluetags
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	PolicyEnforcementError = "error"
+	PolicyEnforcementWarn  = "warn"
+)
+
+const (
+	KeyCaseCamel  = "camelCase"
+	KeyCaseKebab  = "kebab-case"
+	KeyCaseLower  = "lowercase"
+	KeyCasePascal = "PascalCase"
+	KeyCaseSnake  = "snake_case"
+	KeyCaseUpper  = "UPPERCASE"
+)
+
+// PolicyEnforcement_Values returns all tag policy enforcement modes.
+func PolicyEnforcement_Values() []string {
+	return []string{
+		PolicyEnforcementError,
+		PolicyEnforcementWarn,
+	}
+}
+
+// KeyCase_Values returns all tag key casing conventions.
+func KeyCase_Values() []string {
+	return []string{
+		KeyCaseCamel,
+		KeyCaseKebab,
+		KeyCaseLower,
+		KeyCasePascal,
+		KeyCaseSnake,
+		KeyCaseUpper,
+	}
+}
+
+// PolicyConfig contains organizational tag policy rules evaluated against resource tags.
+type PolicyConfig struct {
+	// Enforcement is either PolicyEnforcementError or PolicyEnforcementWarn.
+	Enforcement string
+
+	Rules []PolicyRule
+}
+
+// PolicyRule is a tag policy rule.
+type PolicyRule interface {
+	// Check returns the violations of the rule by the tags.
+	Check(tags KeyValueTags) []PolicyViolation
+}
+
+// PolicyViolation describes tags not conforming to a tag policy rule.
+type PolicyViolation struct {
+	Key     string
+	Message string
+}
+
+func (v PolicyViolation) String() string {
+	return fmt.Sprintf("tag %q: %s", v.Key, v.Message)
+}
+
+// Check returns the violations of all policy rules by the tags, sorted by key.
+// A nil PolicyConfig has no rules.
+func (pc *PolicyConfig) Check(tags KeyValueTags) []PolicyViolation {
+	if pc == nil {
+		return nil
+	}
+
+	var violations []PolicyViolation
+
+	for _, rule := range pc.Rules {
+		violations = append(violations, rule.Check(tags)...)
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Key < violations[j].Key
+	})
+
+	return violations
+}
+
+// Warn reports whether violations are warnings rather than errors.
+func (pc *PolicyConfig) Warn() bool {
+	return pc != nil && pc.Enforcement == PolicyEnforcementWarn
+}
+
+// RequiredKeysRule requires tags with each of the keys.
+type RequiredKeysRule []string
+
+func (r RequiredKeysRule) Check(tags KeyValueTags) []PolicyViolation {
+	var violations []PolicyViolation
+
+	for _, key := range r {
+		if _, ok := tags[key]; !ok {
+			violations = append(violations, PolicyViolation{Key: key, Message: "required tag is missing"})
+		}
+	}
+
+	return violations
+}
+
+// AllowedValuesRule restricts the values of the tag with the key.
+type AllowedValuesRule struct {
+	Key    string
+	Values []string
+}
+
+func (r AllowedValuesRule) Check(tags KeyValueTags) []PolicyViolation {
+	v, ok := tags[r.Key]
+
+	if !ok {
+		return nil
+	}
+
+	value := ""
+	if v != nil && v.Value != nil {
+		value = *v.Value
+	}
+
+	for _, allowed := range r.Values {
+		if value == allowed {
+			return nil
+		}
+	}
+
+	return []PolicyViolation{{
+		Key:     r.Key,
+		Message: fmt.Sprintf("value %q is not one of the allowed values (%s)", value, strings.Join(r.Values, ", ")),
+	}}
+}
+
+// KeyCaseRule requires tag keys to follow a casing convention, e.g. KeyCasePascal.
+// Keys with the aws: prefix are not checked.
+type KeyCaseRule string
+
+var keyCaseRegexps = map[string]*regexp.Regexp{
+	KeyCaseCamel:  regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+	KeyCaseKebab:  regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+	KeyCaseLower:  regexp.MustCompile(`^[^A-Z]*$`),
+	KeyCasePascal: regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+	KeyCaseSnake:  regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`),
+	KeyCaseUpper:  regexp.MustCompile(`^[^a-z]*$`),
+}
+
+func (r KeyCaseRule) Check(tags KeyValueTags) []PolicyViolation {
+	re, ok := keyCaseRegexps[string(r)]
+
+	if !ok {
+		return nil
+	}
+
+	var violations []PolicyViolation
+
+	for key := range tags.IgnoreAws() {
+		if !re.MatchString(key) {
+			violations = append(violations, PolicyViolation{Key: key, Message: fmt.Sprintf("key is not %s", r)})
+		}
+	}
+
+	return violations
+}
+
+// MaxKeyLengthRule limits the number of characters of tag keys.
+type MaxKeyLengthRule int
+
+func (r MaxKeyLengthRule) Check(tags KeyValueTags) []PolicyViolation {
+	var violations []PolicyViolation
+
+	for key := range tags {
+		if n := len([]rune(key)); n > int(r) {
+			violations = append(violations, PolicyViolation{Key: key, Message: fmt.Sprintf("key length (%d) exceeds the maximum (%d)", n, r)})
+		}
+	}
+
+	return violations
+}
+
+// MaxValueLengthRule limits the number of characters of tag values.
+type MaxValueLengthRule int
+
+func (r MaxValueLengthRule) Check(tags KeyValueTags) []PolicyViolation {
+	var violations []PolicyViolation
+
+	for key, v := range tags {
+		if v == nil || v.Value == nil {
+			continue
+		}
+
+		if n := len([]rune(*v.Value)); n > int(r) {
+			violations = append(violations, PolicyViolation{Key: key, Message: fmt.Sprintf("value length (%d) exceeds the maximum (%d)", n, r)})
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,113 @@
+package keyvaluetags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPolicyConfigCheck(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy *PolicyConfig
+		tags   KeyValueTags
+		want   []string
+	}{
+		{
+			name:   "nil policy",
+			policy: nil,
+			tags:   New(map[string]string{"key1": "value1"}),
+		},
+		{
+			name:   "required keys",
+			policy: &PolicyConfig{Rules: []PolicyRule{RequiredKeysRule{"Owner", "Project"}}},
+			tags:   New(map[string]string{"Owner": "team"}),
+			want:   []string{`tag "Project": required tag is missing`},
+		},
+		{
+			name: "allowed values",
+			policy: &PolicyConfig{Rules: []PolicyRule{
+				AllowedValuesRule{Key: "Environment", Values: []string{"dev", "prod"}},
+				AllowedValuesRule{Key: "Missing", Values: []string{"any"}},
+			}},
+			tags: New(map[string]string{"Environment": "test"}),
+			want: []string{`tag "Environment": value "test" is not one of the allowed values (dev, prod)`},
+		},
+		{
+			name:   "key case",
+			policy: &PolicyConfig{Rules: []PolicyRule{KeyCaseRule(KeyCasePascal)}},
+			tags:   New(map[string]string{"CostCenter": "1", "cost-center": "2", "aws:cloudformation:stack-name": "3"}),
+			want:   []string{`tag "cost-center": key is not PascalCase`},
+		},
+		{
+			name: "lengths",
+			policy: &PolicyConfig{Rules: []PolicyRule{
+				MaxKeyLengthRule(4),
+				MaxValueLengthRule(3),
+			}},
+			tags: New(map[string]string{"long-key": "ok", "key": "long value"}),
+			want: []string{
+				`tag "key": value length (10) exceeds the maximum (3)`,
+				`tag "long-key": key length (8) exceeds the maximum (4)`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var got []string
+
+			for _, violation := range testCase.policy.Check(testCase.tags) {
+				got = append(got, violation.String())
+			}
+
+			if !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("got %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestKeyCaseRuleCheck(t *testing.T) {
+	testCases := []struct {
+		keyCase string
+		valid   []string
+		invalid []string
+	}{
+		{KeyCaseCamel, []string{"costCenter", "owner"}, []string{"CostCenter", "cost-center"}},
+		{KeyCaseKebab, []string{"cost-center", "owner"}, []string{"costCenter", "cost_center"}},
+		{KeyCaseLower, []string{"cost-center", "cost_center"}, []string{"costCenter"}},
+		{KeyCasePascal, []string{"CostCenter", "Owner"}, []string{"costCenter", "Cost-Center"}},
+		{KeyCaseSnake, []string{"cost_center", "owner"}, []string{"costCenter", "cost-center"}},
+		{KeyCaseUpper, []string{"COST_CENTER", "OWNER"}, []string{"Owner"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.keyCase, func(t *testing.T) {
+			rule := KeyCaseRule(testCase.keyCase)
+
+			for _, key := range testCase.valid {
+				if violations := rule.Check(New([]string{key})); len(violations) != 0 {
+					t.Errorf("expected %q to be valid, got: %v", key, violations)
+				}
+			}
+
+			for _, key := range testCase.invalid {
+				if violations := rule.Check(New([]string{key})); len(violations) != 1 {
+					t.Errorf("expected %q to be invalid", key)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyConfigWarn(t *testing.T) {
+	var policy *PolicyConfig
+
+	if policy.Warn() {
+		t.Error("expected nil policy not to warn")
+	}
+
+	if !(&PolicyConfig{Enforcement: PolicyEnforcementWarn}).Warn() {
+		t.Error("expected warn enforcement to warn")
+	}
+}
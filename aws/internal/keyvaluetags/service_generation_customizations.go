@@ -5,6 +5,8 @@ package keyvaluetags
 import (
 	"fmt"
 	"reflect"
+	"regexp"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
@@ -559,6 +561,55 @@ func ServiceRetryCreationOnResourceNotFound(serviceName string) string {
 	}
 }
 
+// TagConstraints describes the restrictions of an AWS service on resource tags.
+type TagConstraints struct {
+	// MaxKeyLength is the maximum number of characters of tag keys.
+	MaxKeyLength int
+
+	// MaxValueLength is the maximum number of characters of tag values.
+	MaxValueLength int
+
+	// MaxTags is the maximum number of tags per resource.
+	MaxTags int
+
+	// MaxTagsPerRequest is the maximum number of tags per tagging or untagging request, or 0 if unlimited.
+	MaxTagsPerRequest int
+
+	// AllowedCharacters matches tag keys and values consisting only of allowed characters.
+	// When nil, all characters are allowed.
+	AllowedCharacters *regexp.Regexp
+}
+
+// tagAllowedCharacters matches the characters allowed by most AWS services:
+// letters, spaces, numbers and _ . : / = + - @
+var tagAllowedCharacters = regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`)
+
+// ServiceTagConstraints determines the service tag constraints.
+// The pseudo service name "s3object" returns the constraints of S3 object tags.
+func ServiceTagConstraints(serviceName string) TagConstraints {
+	constraints := TagConstraints{
+		MaxKeyLength:      128,
+		MaxValueLength:    256,
+		MaxTags:           50,
+		AllowedCharacters: tagAllowedCharacters,
+	}
+
+	switch serviceName {
+	case "datapipeline":
+		constraints.MaxTags = 10
+	case "ec2":
+		constraints.AllowedCharacters = nil
+	case "kinesis":
+		constraints.MaxTagsPerRequest = 10
+	case "opsworks":
+		constraints.MaxTags = 40
+	case "s3object":
+		constraints.MaxTags = 10
+	}
+
+	return constraints
+}
+
 // ServiceTagFunction determines the service tagging function.
 func ServiceTagFunction(serviceName string) string {
 	switch serviceName {
@@ -633,12 +684,11 @@ func ServiceTagFunction(serviceName string) string {
 
 // ServiceTagFunctionBatchSize determines the batch size (if any) for tagging and untagging.
 func ServiceTagFunctionBatchSize(serviceName string) string {
-	switch serviceName {
-	case "kinesis":
-		return "10"
-	default:
-		return ""
+	if n := ServiceTagConstraints(serviceName).MaxTagsPerRequest; n > 0 {
+		return strconv.Itoa(n)
 	}
+
+	return ""
 }
 
 // ServiceTagInputIdentifierField determines the service tag identifier field.
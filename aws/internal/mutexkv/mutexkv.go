@@ -1,48 +1,358 @@
 package mutexkv
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"runtime"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 )
 
-// MutexKV is a simple key/value store for arbitrary mutexes. It can be used to
+// waitWarningInterval is how often a warning with the current lock holders is
+// logged while waiting for a lock.
+var waitWarningInterval = 1 * time.Minute
+
+// MutexKV is a simple key/value store for arbitrary read/write mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+//
+// Keys are freed when no longer locked or waited for. Holders and waiters are
+// recorded with the function that locked the key, see Dump.
 type MutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*entry
+}
+
+// entry is the state of a single key. It is guarded by the MutexKV lock.
+type entry struct {
+	// refs is the number of holders and waiters.
+	refs int
+
+	readers        int
+	writer         bool
+	writersWaiting int
+
+	// changed is closed and replaced whenever the lock is released or a
+	// waiting writer gives up, waking up all waiters.
+	changed chan struct{}
+
+	holders []*holder
+	waiters []*holder
+}
+
+// holder describes a holder of, or a waiter for, a key.
+type holder struct {
+	caller string
+	since  time.Time
+	write  bool
+}
+
+func (h *holder) String() string {
+	mode := "read"
+	if h.write {
+		mode = "write"
+	}
+
+	return fmt.Sprintf("%s (%s, %s)", h.caller, mode, time.Since(h.since).Round(time.Millisecond))
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *MutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	_ = m.acquire(context.Background(), key, true)
+}
+
+// LockContext locks the mutex for the given key, waiting at most until the context is done.
+// Caller is responsible for calling Unlock for the same key if no error is returned.
+func (m *MutexKV) LockContext(ctx context.Context, key string) error {
+	return m.acquire(ctx, key, true)
+}
+
+// RLock locks the mutex for the given key for reading. Any number of readers
+// may hold the mutex at the same time, but not together with a writer.
+// Caller is responsible for calling RUnlock for the same key
+func (m *MutexKV) RLock(key string) {
+	_ = m.acquire(context.Background(), key, false)
+}
+
+// RLockContext locks the mutex for the given key for reading, waiting at most until the context is done.
+// Caller is responsible for calling RUnlock for the same key if no error is returned.
+func (m *MutexKV) RLockContext(ctx context.Context, key string) error {
+	return m.acquire(ctx, key, false)
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *MutexKV) Unlock(key string) {
-	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
-	log.Printf("[DEBUG] Unlocked %q", key)
+	m.release(key, true)
+}
+
+// RUnlock the mutex for the given key. Caller must have called RLock for the same key first
+func (m *MutexKV) RUnlock(key string) {
+	m.release(key, false)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *MutexKV) get(key string) *sync.Mutex {
+// Dump returns a description of the holders of and waiters for all locked keys,
+// with the time since they locked or started waiting.
+func (m *MutexKV) Dump() string {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+
+	keys := make([]string, 0, len(m.store))
+	for key := range m.store {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+
+	for _, key := range keys {
+		fmt.Fprintf(&b, "%q: %s\n", key, m.store[key].describe())
+	}
+
+	return b.String()
+}
+
+func (m *MutexKV) acquire(ctx context.Context, key string, write bool) error {
+	h := &holder{
+		caller: caller(),
+		since:  time.Now(),
+		write:  write,
+	}
+
+	log.Printf("[DEBUG] Locking %q", key)
+
+	m.lock.Lock()
+
+	e := m.get(key)
+	e.refs++
+	waiting := false
+
+	for !e.tryAcquire(write) {
+		if !waiting {
+			waiting = true
+			e.waiters = append(e.waiters, h)
+			if write {
+				e.writersWaiting++
+			}
+		}
+
+		changed := e.changed
+		m.lock.Unlock()
+
+		if err := wait(ctx, key, changed, func() string {
+			m.lock.Lock()
+			defer m.lock.Unlock()
+
+			return e.describe()
+		}); err != nil {
+			m.lock.Lock()
+			e.waiters = remove(e.waiters, h)
+			if write {
+				e.writersWaiting--
+				// Readers may have been waiting only for this writer.
+				e.notify()
+			}
+			m.put(key, e)
+			description := e.describe()
+			m.lock.Unlock()
+
+			return fmt.Errorf("error waiting for lock %q: %w (%s)", key, err, description)
+		}
+
+		m.lock.Lock()
+	}
+
+	if waiting {
+		e.waiters = remove(e.waiters, h)
+		if write {
+			e.writersWaiting--
+		}
+	}
+
+	waited := time.Since(h.since)
+	h.since = time.Now()
+	e.holders = append(e.holders, h)
+
+	m.lock.Unlock()
+
+	log.Printf("[DEBUG] Locked %q after %s", key, waited.Round(time.Millisecond))
+
+	return nil
+}
+
+func (m *MutexKV) release(key string, write bool) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	e, ok := m.store[key]
+
+	if write && (!ok || !e.writer) {
+		panic(fmt.Sprintf("mutexkv: Unlock of unlocked key %q", key))
+	}
+
+	if !write && (!ok || e.readers == 0) {
+		panic(fmt.Sprintf("mutexkv: RUnlock of key %q not locked for reading", key))
+	}
+
+	if write {
+		e.writer = false
+	} else {
+		e.readers--
+	}
+
+	e.holders = removeHolder(e.holders, write, caller())
+	e.notify()
+	m.put(key, e)
+
+	log.Printf("[DEBUG] Unlocked %q", key)
+}
+
+// Returns the entry for the given key, no guarantee of its lock status
+func (m *MutexKV) get(key string) *entry {
+	e, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		e = &entry{
+			changed: make(chan struct{}),
+		}
+		m.store[key] = e
+	}
+	return e
+}
+
+// put releases a reference to the entry for the given key, freeing the key if unused.
+func (m *MutexKV) put(key string, e *entry) {
+	e.refs--
+	if e.refs == 0 {
+		delete(m.store, key)
+	}
+}
+
+// tryAcquire acquires the lock if possible. Waiting writers take precedence over new readers.
+func (e *entry) tryAcquire(write bool) bool {
+	if write {
+		if e.writer || e.readers > 0 {
+			return false
+		}
+
+		e.writer = true
+
+		return true
+	}
+
+	if e.writer || e.writersWaiting > 0 {
+		return false
 	}
-	return mutex
+
+	e.readers++
+
+	return true
+}
+
+func (e *entry) notify() {
+	close(e.changed)
+	e.changed = make(chan struct{})
+}
+
+func (e *entry) describe() string {
+	var holders, waiters []string
+
+	for _, h := range e.holders {
+		holders = append(holders, h.String())
+	}
+
+	for _, h := range e.waiters {
+		waiters = append(waiters, h.String())
+	}
+
+	return fmt.Sprintf("held by [%s], waited for by [%s]", strings.Join(holders, ", "), strings.Join(waiters, ", "))
+}
+
+// wait waits for the channel to be closed, periodically logging a warning with the lock state.
+func wait(ctx context.Context, key string, changed <-chan struct{}, describe func() string) error {
+	ticker := time.NewTicker(waitWarningInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-changed:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			log.Printf("[WARN] Still waiting for lock %q: %s", key, describe())
+		}
+	}
+}
+
+func remove(holders []*holder, h *holder) []*holder {
+	for i, v := range holders {
+		if v == h {
+			return append(holders[:i], holders[i+1:]...)
+		}
+	}
+
+	return holders
+}
+
+// removeHolder removes the holder with the given mode, preferring one locked by the caller,
+// which is the case when unlocking with defer, then the earliest.
+func removeHolder(holders []*holder, write bool, caller string) []*holder {
+	index := -1
+
+	for i, h := range holders {
+		if h.write != write {
+			continue
+		}
+
+		if index == -1 {
+			index = i
+		}
+
+		if h.caller == caller {
+			index = i
+			break
+		}
+	}
+
+	if index == -1 {
+		return holders
+	}
+
+	return append(holders[:index], holders[index+1:]...)
+}
+
+// caller returns the name of the function calling the exported MutexKV method.
+func caller() string {
+	pc, _, _, ok := runtime.Caller(3)
+
+	if !ok {
+		return "unknown"
+	}
+
+	fn := runtime.FuncForPC(pc)
+
+	if fn == nil {
+		return "unknown"
+	}
+
+	name := fn.Name()
+
+	// Trim the package path, e.g. github.com/terraform-providers/terraform-provider-aws/aws.
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	return name
 }
 
 // Returns a properly initialized MutexKV
 func NewMutexKV() *MutexKV {
 	return &MutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*entry),
 	}
 }
@@ -1,6 +1,9 @@
 package mutexkv
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -65,3 +68,141 @@ func TestMutexKVDifferentKeys(t *testing.T) {
 		t.Fatal("Second lock on a different key blocked. This shouldn't happen.")
 	}
 }
+
+func TestMutexKVRLock(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.RLock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv.RLock("foo")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Second read lock blocked. This shouldn't happen.")
+	}
+
+	lockedCh := make(chan struct{})
+
+	go func() {
+		mkv.Lock("foo")
+		close(lockedCh)
+	}()
+
+	select {
+	case <-lockedCh:
+		t.Fatal("Write lock was able to be taken with readers. This shouldn't happen.")
+	case <-time.After(50 * time.Millisecond):
+		// pass
+	}
+
+	mkv.RUnlock("foo")
+	mkv.RUnlock("foo")
+
+	select {
+	case <-lockedCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("Write lock blocked after read unlocks. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVRLockWaitingWriter(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.RLock("foo")
+
+	go mkv.Lock("foo")
+
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := mkv.RLockContext(ctx, "foo"); err == nil {
+		t.Fatal("Read lock was able to be taken with a waiting writer. This shouldn't happen.")
+	}
+}
+
+func TestMutexKVLockContext(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.Lock("foo")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := mkv.LockContext(ctx, "foo")
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded error, got: %v", err)
+	}
+
+	if !strings.Contains(err.Error(), "TestMutexKVLockContext") {
+		t.Errorf("expected error to name the lock holder, got: %s", err)
+	}
+
+	mkv.Unlock("foo")
+
+	if err := mkv.LockContext(context.Background(), "foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestMutexKVCleanup(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.Lock("foo")
+	mkv.RLock("bar")
+
+	if got, want := len(mkv.store), 2; got != want {
+		t.Fatalf("got %d keys, want %d", got, want)
+	}
+
+	mkv.Unlock("foo")
+	mkv.RUnlock("bar")
+
+	if got, want := len(mkv.store), 0; got != want {
+		t.Errorf("got %d keys, want %d", got, want)
+	}
+}
+
+func TestMutexKVDump(t *testing.T) {
+	mkv := NewMutexKV()
+
+	mkv.Lock("foo")
+
+	go func() {
+		mkv.Lock("foo")
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+
+	dump := mkv.Dump()
+
+	if !strings.Contains(dump, `"foo": held by [mutexkv.TestMutexKVDump (write, `) {
+		t.Errorf("unexpected holders in dump: %s", dump)
+	}
+
+	if !strings.Contains(dump, `waited for by [mutexkv.TestMutexKVDump.func1 (write, `) {
+		t.Errorf("unexpected waiters in dump: %s", dump)
+	}
+}
+
+func TestMutexKVUnlockUnlocked(t *testing.T) {
+	mkv := NewMutexKV()
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic")
+		}
+	}()
+
+	mkv.Unlock("foo")
+}
@@ -0,0 +1,155 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Limit configures the rate of API requests for a service.
+type Limit struct {
+	// RequestsPerSecond is the sustained rate of requests.
+	RequestsPerSecond float64
+
+	// Burst is the maximum number of requests sent at once.
+	Burst int
+}
+
+// Limiter is a token bucket rate limiter.
+type Limiter struct {
+	lock   sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a Limiter allowing requestsPerSecond requests per second
+// with bursts of at most burst requests. The bucket starts full.
+func NewLimiter(requestsPerSecond float64, burst int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &Limiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request is allowed or the context is done.
+// It returns how long the caller waited.
+func (l *Limiter) Wait(ctx context.Context) (time.Duration, error) {
+	delay := l.reserve(time.Now())
+
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		l.cancel()
+		return 0, ctx.Err()
+	}
+}
+
+// reserve takes a token, returning how long the caller must wait before it is available.
+func (l *Limiter) reserve(now time.Time) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if elapsed := now.Sub(l.last); elapsed > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+		l.last = now
+	}
+
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token that will not be used.
+func (l *Limiter) cancel() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// limiters holds the Limiters shared by all provider configurations in the process.
+var limiters = struct {
+	lock  sync.Mutex
+	store map[string]*Limiter
+}{
+	store: make(map[string]*Limiter),
+}
+
+// Get returns the Limiter shared for the account, region and service,
+// creating it with limit on first use.
+func Get(accountID, region, service string, limit Limit) *Limiter {
+	key := fmt.Sprintf("%s/%s/%s", accountID, region, strings.ToLower(service))
+
+	limiters.lock.Lock()
+	defer limiters.lock.Unlock()
+
+	limiter, ok := limiters.store[key]
+
+	if !ok {
+		limiter = NewLimiter(limit.RequestsPerSecond, limit.Burst)
+		limiters.store[key] = limiter
+	}
+
+	return limiter
+}
+
+// SignHandler returns a request handler that waits for the Limiter of the
+// request's account, signing region and service before each attempt.
+// limits are keyed by AWS SDK for Go service name, compared case-insensitively.
+func SignHandler(accountID string, limits map[string]Limit) func(*request.Request) {
+	serviceLimits := make(map[string]Limit, len(limits))
+
+	for service, limit := range limits {
+		serviceLimits[strings.ToLower(service)] = limit
+	}
+
+	return func(r *request.Request) {
+		if r.Error != nil {
+			return
+		}
+
+		service := strings.ToLower(r.ClientInfo.ServiceName)
+		limit, ok := serviceLimits[service]
+
+		if !ok {
+			return
+		}
+
+		waited, err := Get(accountID, r.ClientInfo.SigningRegion, service, limit).Wait(r.Context())
+
+		if err != nil {
+			r.Error = err
+			return
+		}
+
+		if waited > 0 {
+			log.Printf("[DEBUG] Rate limited %s %s request for %s", r.ClientInfo.ServiceName, r.Operation.Name, waited)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestLimiterReserve(t *testing.T) {
+	limiter := NewLimiter(10, 2)
+	now := limiter.last
+
+	testCases := []struct {
+		Name     string
+		Elapsed  time.Duration
+		Expected time.Duration
+	}{
+		{Name: "first burst request", Expected: 0},
+		{Name: "second burst request", Expected: 0},
+		{Name: "bucket empty", Expected: 100 * time.Millisecond},
+		{Name: "bucket still empty", Expected: 200 * time.Millisecond},
+		{Name: "bucket refilled", Elapsed: time.Second, Expected: 0},
+	}
+
+	for _, testCase := range testCases {
+		now = now.Add(testCase.Elapsed)
+
+		if got, want := limiter.reserve(now), testCase.Expected; got != want {
+			t.Errorf("%s: got delay %s, expected %s", testCase.Name, got, want)
+		}
+	}
+}
+
+func TestLimiterWaitContextDone(t *testing.T) {
+	limiter := NewLimiter(0.001, 1)
+
+	if _, err := limiter.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := limiter.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected %s, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestGet(t *testing.T) {
+	limit := Limit{RequestsPerSecond: 1, Burst: 1}
+
+	if Get("123456789012", "us-east-1", "route53", limit) != Get("123456789012", "us-east-1", "Route53", limit) {
+		t.Error("expected the same Limiter for the same account, region and service")
+	}
+
+	if Get("123456789012", "us-east-1", "route53", limit) == Get("123456789012", "us-west-2", "route53", limit) {
+		t.Error("expected different Limiters for different regions")
+	}
+
+	if Get("123456789012", "us-east-1", "route53", limit) == Get("210987654321", "us-east-1", "route53", limit) {
+		t.Error("expected different Limiters for different accounts")
+	}
+}
+
+func TestSignHandler(t *testing.T) {
+	handler := SignHandler("123456789012", map[string]Limit{
+		"IAM": {RequestsPerSecond: 0.001, Burst: 1},
+	})
+
+	newRequest := func(ctx context.Context, serviceName string) *request.Request {
+		r := &request.Request{
+			ClientInfo: metadata.ClientInfo{
+				ServiceName:   serviceName,
+				SigningRegion: "us-east-1",
+			},
+			HTTPRequest: &http.Request{},
+			Operation:   &request.Operation{Name: "GetUser"},
+		}
+		r.SetContext(ctx)
+
+		return r
+	}
+
+	r := newRequest(context.Background(), "iam")
+	handler(r)
+
+	if r.Error != nil {
+		t.Fatalf("first request: unexpected error: %s", r.Error)
+	}
+
+	r = newRequest(context.Background(), "ec2")
+	handler(r)
+
+	if r.Error != nil {
+		t.Fatalf("unlimited service: unexpected error: %s", r.Error)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	r = newRequest(ctx, "iam")
+	handler(r)
+
+	if r.Error != context.DeadlineExceeded {
+		t.Fatalf("second request: expected %s, got %v", context.DeadlineExceeded, r.Error)
+	}
+}
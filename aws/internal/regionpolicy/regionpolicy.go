@@ -0,0 +1,83 @@
+package regionpolicy
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// Config is the provider-level policy restricting the AWS Regions and partitions used.
+type Config struct {
+	// AllowedRegions, when not empty, lists the only permitted Regions.
+	AllowedRegions []string
+
+	// ForbiddenRegions lists Regions that are never permitted.
+	ForbiddenRegions []string
+
+	// AllowedPartitions, when not empty, lists the only permitted partitions, e.g. "aws".
+	AllowedPartitions []string
+}
+
+// Validate returns an error if the policy does not permit the Region or its partition.
+// The Region's partition is determined from the AWS SDK for Go endpoints metadata.
+// A nil Config permits all Regions.
+func (c *Config) Validate(region string) error {
+	if err := c.ValidateRegion(region); err != nil {
+		return err
+	}
+
+	if c == nil || len(c.AllowedPartitions) == 0 {
+		return nil
+	}
+
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+
+	if !ok {
+		return fmt.Errorf("unable to determine partition of AWS Region (%s) to validate against allowed_partitions", region)
+	}
+
+	return c.ValidatePartition(partition.ID())
+}
+
+// ValidateRegion returns an error if the policy does not permit the Region,
+// without considering its partition.
+// A nil Config permits all Regions.
+func (c *Config) ValidateRegion(region string) error {
+	if c == nil {
+		return nil
+	}
+
+	if len(c.AllowedRegions) > 0 && !contains(c.AllowedRegions, region) {
+		return fmt.Errorf("AWS Region (%s) is not one of the allowed_regions", region)
+	}
+
+	if contains(c.ForbiddenRegions, region) {
+		return fmt.Errorf("AWS Region (%s) is one of the forbidden_regions", region)
+	}
+
+	return nil
+}
+
+// ValidatePartition returns an error if the policy does not permit the partition.
+// A nil Config permits all partitions.
+func (c *Config) ValidatePartition(partition string) error {
+	if c == nil {
+		return nil
+	}
+
+	if len(c.AllowedPartitions) > 0 && !contains(c.AllowedPartitions, partition) {
+		return fmt.Errorf("AWS partition (%s) is not one of the allowed_partitions", partition)
+	}
+
+	return nil
+}
+
+func contains(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,109 @@
+package regionpolicy
+
+import (
+	"testing"
+)
+
+func TestConfigValidate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Config        *Config
+		Region        string
+		ExpectedError bool
+	}{
+		{
+			Name:   "nil config",
+			Region: "us-west-2",
+		},
+		{
+			Name:   "empty config",
+			Config: &Config{},
+			Region: "us-west-2",
+		},
+		{
+			Name: "allowed",
+			Config: &Config{
+				AllowedRegions: []string{"us-east-1", "us-west-2"},
+			},
+			Region: "us-west-2",
+		},
+		{
+			Name: "not allowed",
+			Config: &Config{
+				AllowedRegions: []string{"us-east-1", "us-west-2"},
+			},
+			Region:        "eu-west-1",
+			ExpectedError: true,
+		},
+		{
+			Name: "forbidden",
+			Config: &Config{
+				ForbiddenRegions: []string{"ap-east-1"},
+			},
+			Region:        "ap-east-1",
+			ExpectedError: true,
+		},
+		{
+			Name: "not forbidden",
+			Config: &Config{
+				ForbiddenRegions: []string{"ap-east-1"},
+			},
+			Region: "eu-west-1",
+		},
+		{
+			Name: "partition allowed",
+			Config: &Config{
+				AllowedPartitions: []string{"aws"},
+			},
+			Region: "eu-west-1",
+		},
+		{
+			Name: "partition not allowed",
+			Config: &Config{
+				AllowedPartitions: []string{"aws"},
+			},
+			Region:        "cn-north-1",
+			ExpectedError: true,
+		},
+		{
+			Name: "partition unknown",
+			Config: &Config{
+				AllowedPartitions: []string{"aws"},
+			},
+			Region:        "mars-central-1",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Config.Validate(testCase.Region)
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestConfigValidatePartition(t *testing.T) {
+	var c *Config
+
+	if err := c.ValidatePartition("aws-cn"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	c = &Config{AllowedPartitions: []string{"aws", "aws-us-gov"}}
+
+	if err := c.ValidatePartition("aws-us-gov"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := c.ValidatePartition("aws-cn"); err == nil {
+		t.Error("expected error")
+	}
+}
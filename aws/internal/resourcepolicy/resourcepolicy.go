@@ -0,0 +1,85 @@
+package resourcepolicy
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Config is the provider-level policy restricting which resource types may be managed.
+type Config struct {
+	// AllowedResourceTypes holds glob patterns, e.g. "aws_s3_*".
+	// When not empty, a resource type must match at least one pattern.
+	AllowedResourceTypes []string
+
+	// DeniedResourceTypes holds glob patterns of resource types that are never permitted.
+	// Denied patterns take precedence over allowed patterns.
+	DeniedResourceTypes []string
+}
+
+// ValidatePattern returns an error if the glob pattern is malformed.
+// Patterns use the syntax of path.Match.
+func ValidatePattern(pattern string) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("invalid resource type pattern (%s): %w", pattern, err)
+	}
+
+	return nil
+}
+
+// Check returns an error if the policy does not permit the resource type.
+// A nil Config permits all resource types.
+func (c *Config) Check(resourceType string) error {
+	if c == nil {
+		return nil
+	}
+
+	if pattern, ok := match(c.DeniedResourceTypes, resourceType); ok {
+		return fmt.Errorf("resource type %s is denied by the provider resource_policy (denied_resource_types pattern %q)", resourceType, pattern)
+	}
+
+	if len(c.AllowedResourceTypes) == 0 {
+		return nil
+	}
+
+	if _, ok := match(c.AllowedResourceTypes, resourceType); !ok {
+		return fmt.Errorf("resource type %s is not allowed by the provider resource_policy (no allowed_resource_types pattern matches)", resourceType)
+	}
+
+	return nil
+}
+
+func match(patterns []string, resourceType string) (string, bool) {
+	for _, pattern := range patterns {
+		// Patterns are validated when the provider is configured.
+		if ok, _ := path.Match(pattern, resourceType); ok {
+			return pattern, true
+		}
+	}
+
+	return "", false
+}
+
+// ConfigFunc returns the policy for the provider meta, or nil if no policy is configured.
+type ConfigFunc func(meta interface{}) *Config
+
+// WrapResource enforces the policy for the provider meta when planning the named resource.
+// Any existing CustomizeDiff function runs only if the resource type is permitted.
+// Destroy plans do not call CustomizeDiff, so resources of a denied type can still be destroyed.
+func WrapResource(name string, r *schema.Resource, policy ConfigFunc) {
+	customizeDiff := r.CustomizeDiff
+
+	r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if err := policy(meta).Check(name); err != nil {
+			return err
+		}
+
+		if customizeDiff == nil {
+			return nil
+		}
+
+		return customizeDiff(ctx, diff, meta)
+	}
+}
@@ -0,0 +1,130 @@
+package resourcepolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestConfigCheck(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Config        *Config
+		ResourceType  string
+		ExpectedError bool
+	}{
+		{
+			Name:         "nil config",
+			ResourceType: "aws_iam_user",
+		},
+		{
+			Name:         "empty config",
+			Config:       &Config{},
+			ResourceType: "aws_iam_user",
+		},
+		{
+			Name: "denied exact",
+			Config: &Config{
+				DeniedResourceTypes: []string{"aws_default_vpc"},
+			},
+			ResourceType:  "aws_default_vpc",
+			ExpectedError: true,
+		},
+		{
+			Name: "denied glob",
+			Config: &Config{
+				DeniedResourceTypes: []string{"aws_iam_user*"},
+			},
+			ResourceType:  "aws_iam_user_login_profile",
+			ExpectedError: true,
+		},
+		{
+			Name: "not denied",
+			Config: &Config{
+				DeniedResourceTypes: []string{"aws_iam_user*"},
+			},
+			ResourceType: "aws_iam_role",
+		},
+		{
+			Name: "allowed glob",
+			Config: &Config{
+				AllowedResourceTypes: []string{"aws_s3_*", "aws_kms_key"},
+			},
+			ResourceType: "aws_s3_bucket",
+		},
+		{
+			Name: "not allowed",
+			Config: &Config{
+				AllowedResourceTypes: []string{"aws_s3_*", "aws_kms_key"},
+			},
+			ResourceType:  "aws_instance",
+			ExpectedError: true,
+		},
+		{
+			Name: "denied takes precedence",
+			Config: &Config{
+				AllowedResourceTypes: []string{"aws_s3_*"},
+				DeniedResourceTypes:  []string{"aws_s3_bucket_policy"},
+			},
+			ResourceType:  "aws_s3_bucket_policy",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Config.Check(testCase.ResourceType)
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestValidatePattern(t *testing.T) {
+	if err := ValidatePattern("aws_s3_*"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := ValidatePattern("aws_s3_[bucket"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestWrapResource(t *testing.T) {
+	called := false
+	r := &schema.Resource{
+		CustomizeDiff: func(context.Context, *schema.ResourceDiff, interface{}) error {
+			called = true
+			return nil
+		},
+	}
+	config := &Config{}
+
+	WrapResource("aws_default_vpc", r, func(interface{}) *Config { return config })
+
+	if err := r.CustomizeDiff(context.Background(), nil, nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !called {
+		t.Fatal("expected wrapped CustomizeDiff to be called")
+	}
+
+	called = false
+	config.DeniedResourceTypes = []string{"aws_default_*"}
+
+	if err := r.CustomizeDiff(context.Background(), nil, nil); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if called {
+		t.Fatal("expected wrapped CustomizeDiff not to be called")
+	}
+}
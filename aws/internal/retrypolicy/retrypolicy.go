@@ -0,0 +1,198 @@
+package retrypolicy
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+const (
+	// JitterModeFull randomizes each backoff delay, as the AWS SDK for Go does by default.
+	JitterModeFull = "full"
+
+	// JitterModeNone uses the exponential backoff delay without randomization.
+	JitterModeNone = "none"
+)
+
+// JitterMode_Values returns all elements of the JitterMode enum.
+func JitterMode_Values() []string {
+	return []string{
+		JitterModeFull,
+		JitterModeNone,
+	}
+}
+
+// Config is the provider-level retry policy applied to all AWS service clients.
+type Config struct {
+	// MaxAttempts is the maximum number of attempts for each API request,
+	// including the initial request. Zero means the provider max_retries is used.
+	MaxAttempts int
+
+	// MaxBackoff caps the delay between attempts. Zero means the AWS SDK for Go default.
+	MaxBackoff time.Duration
+
+	// JitterMode is one of the JitterMode values. Empty means JitterModeFull.
+	JitterMode string
+
+	// RetryableErrorCodes holds additional retryable AWS error codes keyed by
+	// AWS SDK for Go service name, e.g. "ec2".
+	RetryableErrorCodes map[string][]string
+}
+
+// Retryer returns a request.Retryer implementing the policy.
+// maxRetries is used when the policy does not set MaxAttempts.
+func (c *Config) Retryer(maxRetries int) request.Retryer {
+	retryer := Retryer{
+		DefaultRetryer: client.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MinRetryDelay:    client.DefaultRetryerMinRetryDelay,
+			MinThrottleDelay: client.DefaultRetryerMinThrottleDelay,
+			MaxRetryDelay:    client.DefaultRetryerMaxRetryDelay,
+			MaxThrottleDelay: client.DefaultRetryerMaxThrottleDelay,
+		},
+		JitterMode: JitterModeFull,
+	}
+
+	if c == nil {
+		return retryer
+	}
+
+	if c.MaxAttempts > 0 {
+		retryer.NumMaxRetries = c.MaxAttempts - 1
+	}
+
+	if c.MaxBackoff > 0 {
+		retryer.MaxRetryDelay = c.MaxBackoff
+		retryer.MaxThrottleDelay = c.MaxBackoff
+
+		if retryer.MinRetryDelay > c.MaxBackoff {
+			retryer.MinRetryDelay = c.MaxBackoff
+		}
+
+		if retryer.MinThrottleDelay > c.MaxBackoff {
+			retryer.MinThrottleDelay = c.MaxBackoff
+		}
+	}
+
+	if c.JitterMode != "" {
+		retryer.JitterMode = c.JitterMode
+	}
+
+	return retryer
+}
+
+// RetryableErrors returns the policy's additional retryable error codes.
+func (c *Config) RetryableErrors() []RetryableError {
+	if c == nil {
+		return nil
+	}
+
+	var errs []RetryableError
+
+	for service, codes := range c.RetryableErrorCodes {
+		for _, code := range codes {
+			errs = append(errs, RetryableError{
+				Service: service,
+				Code:    code,
+			})
+		}
+	}
+
+	return errs
+}
+
+// Retryer is a request.Retryer with a configurable jitter mode.
+type Retryer struct {
+	client.DefaultRetryer
+
+	JitterMode string
+}
+
+// RetryRules returns the delay before the next attempt of the request.
+func (r Retryer) RetryRules(req *request.Request) time.Duration {
+	if r.JitterMode != JitterModeNone {
+		return r.DefaultRetryer.RetryRules(req)
+	}
+
+	if r.NumMaxRetries == 0 {
+		return 0
+	}
+
+	minDelay, maxDelay := r.MinRetryDelay, r.MaxRetryDelay
+
+	if req.IsErrorThrottle() {
+		minDelay, maxDelay = r.MinThrottleDelay, r.MaxThrottleDelay
+	}
+
+	delay := minDelay
+
+	for i := 0; i < req.RetryCount && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	return delay
+}
+
+// RetryableError describes an AWS API error that should always be retried.
+type RetryableError struct {
+	// Service is the AWS SDK for Go service name, compared case-insensitively.
+	Service string
+
+	// Operations optionally limits the error to the named API operations.
+	Operations []string
+
+	// Code is the AWS error code.
+	Code string
+
+	// Message optionally limits the error to messages containing the value.
+	Message string
+}
+
+// Matches returns true if the request failed with the error.
+func (e RetryableError) Matches(r *request.Request) bool {
+	if !strings.EqualFold(e.Service, r.ClientInfo.ServiceName) {
+		return false
+	}
+
+	if len(e.Operations) > 0 {
+		matched := false
+
+		for _, operation := range e.Operations {
+			if r.Operation != nil && r.Operation.Name == operation {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return tfawserr.ErrMessageContains(r.Error, e.Code, e.Message)
+}
+
+// RetryHandler returns a request retry handler marking requests that failed
+// with any of the errors as retryable.
+func RetryHandler(errs []RetryableError) func(*request.Request) {
+	return func(r *request.Request) {
+		if r.Error == nil {
+			return
+		}
+
+		for _, e := range errs {
+			if e.Matches(r) {
+				r.Retryable = aws.Bool(true)
+				return
+			}
+		}
+	}
+}
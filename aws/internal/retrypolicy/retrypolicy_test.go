@@ -0,0 +1,179 @@
+package retrypolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestConfigRetryer(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Config             *Config
+		MaxRetries         int
+		ExpectedMaxRetries int
+		ExpectedMaxDelay   time.Duration
+		ExpectedJitterMode string
+	}{
+		{
+			Name:               "nil config",
+			MaxRetries:         25,
+			ExpectedMaxRetries: 25,
+			ExpectedMaxDelay:   300 * time.Second,
+			ExpectedJitterMode: JitterModeFull,
+		},
+		{
+			Name:               "empty config",
+			Config:             &Config{},
+			MaxRetries:         25,
+			ExpectedMaxRetries: 25,
+			ExpectedMaxDelay:   300 * time.Second,
+			ExpectedJitterMode: JitterModeFull,
+		},
+		{
+			Name: "all settings",
+			Config: &Config{
+				MaxAttempts: 5,
+				MaxBackoff:  20 * time.Second,
+				JitterMode:  JitterModeNone,
+			},
+			MaxRetries:         25,
+			ExpectedMaxRetries: 4,
+			ExpectedMaxDelay:   20 * time.Second,
+			ExpectedJitterMode: JitterModeNone,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryer := testCase.Config.Retryer(testCase.MaxRetries).(Retryer)
+
+			if got, want := retryer.MaxRetries(), testCase.ExpectedMaxRetries; got != want {
+				t.Errorf("got max retries %d, expected %d", got, want)
+			}
+
+			if got, want := retryer.MaxRetryDelay, testCase.ExpectedMaxDelay; got != want {
+				t.Errorf("got max retry delay %s, expected %s", got, want)
+			}
+
+			if got, want := retryer.MaxThrottleDelay, testCase.ExpectedMaxDelay; got != want {
+				t.Errorf("got max throttle delay %s, expected %s", got, want)
+			}
+
+			if got, want := retryer.JitterMode, testCase.ExpectedJitterMode; got != want {
+				t.Errorf("got jitter mode %q, expected %q", got, want)
+			}
+		})
+	}
+}
+
+func TestRetryerRetryRulesNoJitter(t *testing.T) {
+	retryer := (&Config{
+		MaxAttempts: 10,
+		MaxBackoff:  time.Second,
+		JitterMode:  JitterModeNone,
+	}).Retryer(0)
+
+	testCases := []struct {
+		RetryCount int
+		Expected   time.Duration
+	}{
+		{RetryCount: 0, Expected: 30 * time.Millisecond},
+		{RetryCount: 1, Expected: 60 * time.Millisecond},
+		{RetryCount: 3, Expected: 240 * time.Millisecond},
+		{RetryCount: 6, Expected: time.Second},
+		{RetryCount: 100, Expected: time.Second},
+	}
+
+	for _, testCase := range testCases {
+		req := &request.Request{
+			Error:      awserr.New("InternalError", "test", nil),
+			RetryCount: testCase.RetryCount,
+		}
+
+		if got, want := retryer.RetryRules(req), testCase.Expected; got != want {
+			t.Errorf("retry count %d: got delay %s, expected %s", testCase.RetryCount, got, want)
+		}
+	}
+}
+
+func TestRetryHandler(t *testing.T) {
+	handler := RetryHandler([]RetryableError{
+		{
+			Service: "ec2",
+			Code:    "RequestLimitExceeded",
+		},
+		{
+			Service:    "wafv2",
+			Operations: []string{"CreateWebACL"},
+			Code:       "WAFInternalErrorException",
+			Message:    "Retry your request",
+		},
+	})
+
+	testCases := []struct {
+		Name        string
+		ServiceName string
+		Operation   string
+		Error       error
+		Expected    bool
+	}{
+		{
+			Name:        "no error",
+			ServiceName: "ec2",
+			Operation:   "DescribeVpcs",
+		},
+		{
+			Name:        "matching code",
+			ServiceName: "ec2",
+			Operation:   "DescribeVpcs",
+			Error:       awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+			Expected:    true,
+		},
+		{
+			Name:        "other service",
+			ServiceName: "dynamodb",
+			Operation:   "PutItem",
+			Error:       awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+		},
+		{
+			Name:        "matching operation and message case-insensitive service",
+			ServiceName: "WAFV2",
+			Operation:   "CreateWebACL",
+			Error:       awserr.New("WAFInternalErrorException", "Retry your request", nil),
+			Expected:    true,
+		},
+		{
+			Name:        "other operation",
+			ServiceName: "WAFV2",
+			Operation:   "DeleteWebACL",
+			Error:       awserr.New("WAFInternalErrorException", "Retry your request", nil),
+		},
+		{
+			Name:        "other message",
+			ServiceName: "WAFV2",
+			Operation:   "CreateWebACL",
+			Error:       awserr.New("WAFInternalErrorException", "Something else", nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			req := &request.Request{
+				ClientInfo: metadata.ClientInfo{ServiceName: testCase.ServiceName},
+				Error:      testCase.Error,
+				Operation:  &request.Operation{Name: testCase.Operation},
+			}
+
+			handler(req)
+
+			if got, want := aws.BoolValue(req.Retryable), testCase.Expected; got != want {
+				t.Errorf("got retryable %t, expected %t", got, want)
+			}
+		})
+	}
+}
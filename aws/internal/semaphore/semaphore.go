@@ -0,0 +1,168 @@
+package semaphore
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"log"
+	"sync"
+)
+
+// Weighted is a context-aware weighted semaphore limiting concurrent operations,
+// e.g. to stay within the concurrency quotas of an AWS service.
+// Waiters acquire the semaphore in FIFO order.
+type Weighted struct {
+	lock    sync.Mutex
+	size    int64
+	current int64
+	waiters list.List
+}
+
+type waiter struct {
+	n     int64
+	ready chan struct{}
+}
+
+// NewWeighted returns a Weighted semaphore with the given maximum combined weight.
+func NewWeighted(n int64) *Weighted {
+	return &Weighted{size: n}
+}
+
+// Acquire acquires the semaphore with a weight of n, blocking until resources
+// are available or the context is done. On failure, it returns the context error
+// and leaves the semaphore unchanged.
+func (s *Weighted) Acquire(ctx context.Context, n int64) error {
+	s.lock.Lock()
+
+	if s.size-s.current >= n && s.waiters.Len() == 0 {
+		s.current += n
+		s.lock.Unlock()
+		return nil
+	}
+
+	if n > s.size {
+		s.lock.Unlock()
+		return fmt.Errorf("weight (%d) exceeds semaphore size (%d)", n, s.size)
+	}
+
+	ready := make(chan struct{})
+	element := s.waiters.PushBack(waiter{n: n, ready: ready})
+	s.lock.Unlock()
+
+	select {
+	case <-ctx.Done():
+		err := ctx.Err()
+
+		s.lock.Lock()
+		select {
+		case <-ready:
+			// Acquired after the context was done, give it back.
+			s.current -= n
+			s.notifyWaiters()
+		default:
+			isFront := s.waiters.Front() == element
+			s.waiters.Remove(element)
+			// The next waiters may fit now that this one is gone.
+			if isFront && s.size > s.current {
+				s.notifyWaiters()
+			}
+		}
+		s.lock.Unlock()
+
+		return err
+	case <-ready:
+		return nil
+	}
+}
+
+// TryAcquire acquires the semaphore with a weight of n without blocking.
+// On success, returns true. On failure, returns false and leaves the semaphore unchanged.
+func (s *Weighted) TryAcquire(n int64) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.size-s.current >= n && s.waiters.Len() == 0 {
+		s.current += n
+		return true
+	}
+
+	return false
+}
+
+// Release releases the semaphore with a weight of n.
+func (s *Weighted) Release(n int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.current -= n
+
+	if s.current < 0 {
+		panic("semaphore: released more than held")
+	}
+
+	s.notifyWaiters()
+}
+
+func (s *Weighted) notifyWaiters() {
+	for {
+		next := s.waiters.Front()
+
+		if next == nil {
+			break
+		}
+
+		w := next.Value.(waiter)
+
+		// Waiters are served in order to avoid starving heavier waiters.
+		if s.size-s.current < w.n {
+			break
+		}
+
+		s.current += w.n
+		s.waiters.Remove(next)
+		close(w.ready)
+	}
+}
+
+// Limits holds a Weighted semaphore for each limited key, e.g. an AWS service name.
+type Limits map[string]*Weighted
+
+// NewLimits returns Limits allowing the given number of concurrent operations per key.
+func NewLimits(limits map[string]int) Limits {
+	if len(limits) == 0 {
+		return nil
+	}
+
+	result := make(Limits, len(limits))
+
+	for key, n := range limits {
+		result[key] = NewWeighted(int64(n))
+	}
+
+	return result
+}
+
+// Acquire waits, until the context is done, for one of the concurrent operations
+// allowed for the key. The returned function releases the operation.
+// Keys without limit are acquired immediately.
+func (l Limits) Acquire(ctx context.Context, key string) (func(), error) {
+	s, ok := l[key]
+
+	if !ok {
+		return func() {}, nil
+	}
+
+	if !s.TryAcquire(1) {
+		log.Printf("[DEBUG] Waiting for one of the %d concurrent %s operations", s.size, key)
+
+		if err := s.Acquire(ctx, 1); err != nil {
+			return nil, fmt.Errorf("error waiting for one of the %d concurrent %s operations: %w", s.size, key, err)
+		}
+	}
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() { s.Release(1) })
+	}, nil
+}
@@ -0,0 +1,142 @@
+package semaphore
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWeighted(t *testing.T) {
+	s := NewWeighted(2)
+
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := s.Acquire(context.Background(), 1); err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			defer s.Release(1)
+
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+
+			time.Sleep(5 * time.Millisecond)
+		}()
+	}
+
+	wg.Wait()
+
+	if maxRunning > 2 {
+		t.Errorf("got %d concurrent operations, want at most 2", maxRunning)
+	}
+}
+
+func TestWeightedAcquireContext(t *testing.T) {
+	s := NewWeighted(2)
+
+	if err := s.Acquire(context.Background(), 2); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := s.Acquire(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded error, got: %v", err)
+	}
+
+	s.Release(2)
+
+	if !s.TryAcquire(2) {
+		t.Error("expected semaphore to be released after failed acquisition")
+	}
+}
+
+func TestWeightedAcquireTooHeavy(t *testing.T) {
+	s := NewWeighted(1)
+
+	if err := s.Acquire(context.Background(), 2); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestWeightedFIFO(t *testing.T) {
+	s := NewWeighted(2)
+
+	if err := s.Acquire(context.Background(), 1); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	heavyCh := make(chan struct{})
+
+	go func() {
+		_ = s.Acquire(context.Background(), 2)
+		close(heavyCh)
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+
+	if s.TryAcquire(1) {
+		t.Error("expected light acquisition not to overtake waiting heavy acquisition")
+	}
+
+	s.Release(1)
+
+	select {
+	case <-heavyCh:
+		// pass
+	case <-time.After(50 * time.Millisecond):
+		t.Fatal("heavy acquisition blocked after release")
+	}
+}
+
+func TestLimits(t *testing.T) {
+	limits := NewLimits(map[string]int{"eks": 1})
+
+	release, err := limits.Acquire(context.Background(), "eks")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := limits.Acquire(ctx, "eks"); err == nil {
+		t.Error("expected error acquiring limited operation")
+	}
+
+	if _, err := limits.Acquire(ctx, "lambda"); err != nil {
+		t.Errorf("unexpected error acquiring unlimited operation: %s", err)
+	}
+
+	release()
+	release()
+
+	if _, err := limits.Acquire(context.Background(), "eks"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	var nilLimits Limits
+
+	if _, err := nilLimits.Acquire(context.Background(), "eks"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
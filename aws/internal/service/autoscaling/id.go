@@ -0,0 +1,26 @@
+package autoscaling
+
+import (
+	"fmt"
+	"strings"
+)
+
+const attachmentImportIDSeparator = "/"
+
+// AttachmentParseImportID parses an attachment import ID of the form autoscaling-group-name/elb-name
+// or autoscaling-group-name/target-group-arn, returning the group name, ELB name and target group ARN.
+func AttachmentParseImportID(id string) (string, string, string, error) {
+	// Target group ARNs contain the separator, so the ID is split before the ARN prefix.
+	if i := strings.Index(id, attachmentImportIDSeparator+"arn:"); i > 0 {
+		return id[:i], "", id[i+1:], nil
+	}
+
+	// Classic load balancer names cannot contain the separator.
+	if i := strings.LastIndex(id, attachmentImportIDSeparator); i > 0 && i < len(id)-1 {
+		return id[:i], id[i+1:], "", nil
+	}
+
+	return "", "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected autoscaling-group-name"+attachmentImportIDSeparator+
+			"elb-name or autoscaling-group-name"+attachmentImportIDSeparator+"target-group-arn", id)
+}
@@ -0,0 +1,24 @@
+package dynamodb
+
+import (
+	"fmt"
+	"strings"
+)
+
+const tableItemImportIDSeparator = "|"
+
+// TableItemParseImportID parses a table item import ID of the form
+// table-name|hash-key-value[|range-key-value].
+func TableItemParseImportID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, tableItemImportIDSeparator, 3)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], "", nil
+	}
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected table-name"+tableItemImportIDSeparator+"hash-key-value or table-name"+
+			tableItemImportIDSeparator+"hash-key-value"+tableItemImportIDSeparator+"range-key-value", id)
+}
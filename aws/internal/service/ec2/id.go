@@ -93,3 +93,22 @@ func TransitGatewayPrefixListReferenceParseID(id string) (string, string, error)
 func VpnGatewayVpcAttachmentCreateID(vpnGatewayID, vpcID string) string {
 	return fmt.Sprintf("vpn-attachment-%x", hashcode.String(fmt.Sprintf("%s-%s", vpcID, vpnGatewayID)))
 }
+
+const vpnConnectionRouteIDSeparator = ":"
+
+func VpnConnectionRouteCreateID(destinationCidrBlock, vpnConnectionID string) string {
+	parts := []string{destinationCidrBlock, vpnConnectionID}
+	id := strings.Join(parts, vpnConnectionRouteIDSeparator)
+	return id
+}
+
+func VpnConnectionRouteParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, vpnConnectionRouteIDSeparator, 2)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected destination-cidr-block"+vpnConnectionRouteIDSeparator+
+			"vpn-connection-id", id)
+}
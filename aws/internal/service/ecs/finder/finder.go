@@ -0,0 +1,74 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// ServiceByClusterAndID returns the service corresponding to the specified cluster and service name or ARN.
+// Returns nil if no service is found.
+func ServiceByClusterAndID(conn *ecs.ECS, cluster, id string) (*ecs.Service, error) {
+	input := &ecs.DescribeServicesInput{
+		Services: aws.StringSlice([]string{id}),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.DescribeServices(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Services) == 0 {
+		return nil, nil
+	}
+
+	return output.Services[0], nil
+}
+
+// StoppedTasksByStartedBy returns up to maxResults stopped tasks started by the specified
+// principal, e.g. a service deployment ID.
+func StoppedTasksByStartedBy(conn *ecs.ECS, cluster, startedBy string, maxResults int) ([]*ecs.Task, error) {
+	input := &ecs.ListTasksInput{
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		MaxResults:    aws.Int64(int64(maxResults)),
+		StartedBy:     aws.String(startedBy),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.ListTasks(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	describeInput := &ecs.DescribeTasksInput{
+		Tasks: output.TaskArns,
+	}
+
+	if cluster != "" {
+		describeInput.Cluster = aws.String(cluster)
+	}
+
+	describeOutput, err := conn.DescribeTasks(describeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if describeOutput == nil {
+		return nil, nil
+	}
+
+	return describeOutput.Tasks, nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/finder"
 )
 
 const (
@@ -12,6 +13,12 @@ const (
 
 	// EventSubscription Unknown
 	CapacityProviderStatusUnknown = "Unknown"
+
+	// Service Deployment NotFound, e.g. replaced by a later deployment
+	ServiceDeploymentStatusNotFound = "NotFound"
+
+	// Service Deployment Unknown
+	ServiceDeploymentStatusUnknown = "Unknown"
 )
 
 // CapacityProviderStatus fetches the Capacity Provider and its Status
@@ -34,3 +41,38 @@ func CapacityProviderStatus(conn *ecs.ECS, capacityProvider string) resource.Sta
 		return output.CapacityProviders[0], aws.StringValue(output.CapacityProviders[0].Status), nil
 	}
 }
+
+// ServiceDeploymentStatus fetches a Service Deployment and its rollout state.
+// For deployments without a rollout state, e.g. of services behind a Classic Load Balancer,
+// the deployment is considered completed once it is the only deployment and all its tasks are running.
+func ServiceDeploymentStatus(conn *ecs.ECS, cluster, service, deploymentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ServiceByClusterAndID(conn, cluster, service)
+
+		if err != nil {
+			return nil, ServiceDeploymentStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, ServiceDeploymentStatusUnknown, nil
+		}
+
+		for _, deployment := range output.Deployments {
+			if aws.StringValue(deployment.Id) != deploymentID {
+				continue
+			}
+
+			if v := aws.StringValue(deployment.RolloutState); v != "" {
+				return deployment, v, nil
+			}
+
+			if len(output.Deployments) == 1 && aws.Int64Value(deployment.RunningCount) == aws.Int64Value(deployment.DesiredCount) {
+				return deployment, ecs.DeploymentRolloutStateCompleted, nil
+			}
+
+			return deployment, ecs.DeploymentRolloutStateInProgress, nil
+		}
+
+		return &ecs.Deployment{Id: aws.String(deploymentID)}, ServiceDeploymentStatusNotFound, nil
+	}
+}
@@ -1,15 +1,27 @@
 package waiter
 
 import (
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/finder"
 )
 
 const (
 	// Maximum amount of time to wait for a Capacity Provider to return INACTIVE
 	CapacityProviderInactiveTimeout = 20 * time.Minute
+
+	// Default maximum amount of time to wait for a Service Deployment to complete
+	ServiceDeploymentCompletedTimeout = 20 * time.Minute
+
+	// Maximum number of service events and stopped tasks reported for a Service Deployment
+	serviceDeploymentErrorMaxEvents       = 10
+	serviceDeploymentErrorMaxStoppedTasks = 10
 )
 
 // CapacityProviderInactive waits for a Capacity Provider to return INACTIVE
@@ -29,3 +41,134 @@ func CapacityProviderInactive(conn *ecs.ECS, capacityProvider string) (*ecs.Capa
 
 	return nil, err
 }
+
+// ServiceDeploymentCompleted waits for a Service Deployment to complete.
+// When the deployment fails, is replaced or does not complete in time, the returned error is a
+// *ServiceDeploymentError reporting the recent service events and the stop reasons of the deployment tasks.
+func ServiceDeploymentCompleted(conn *ecs.ECS, cluster, service, deploymentID string, timeout time.Duration) (*ecs.Deployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{ecs.DeploymentRolloutStateInProgress},
+		Target:       []string{ecs.DeploymentRolloutStateCompleted},
+		Refresh:      ServiceDeploymentStatus(conn, cluster, service, deploymentID),
+		Timeout:      timeout,
+		PollInterval: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	deployment, _ := outputRaw.(*ecs.Deployment)
+
+	if err != nil {
+		return deployment, newServiceDeploymentError(conn, cluster, service, deploymentID, deployment, err)
+	}
+
+	return deployment, nil
+}
+
+// ServiceDeploymentError describes a Service Deployment which did not complete.
+type ServiceDeploymentError struct {
+	DeploymentID       string
+	RolloutState       string
+	RolloutStateReason string
+	Events             []*ecs.ServiceEvent
+	StoppedTasks       []*ecs.Task
+
+	err error
+}
+
+func (e *ServiceDeploymentError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "ECS Service Deployment (%s) did not complete: %s", e.DeploymentID, e.err)
+
+	if e.RolloutState != "" {
+		fmt.Fprintf(&b, "\n\nRollout state: %s", e.RolloutState)
+
+		if e.RolloutStateReason != "" {
+			fmt.Fprintf(&b, " (%s)", e.RolloutStateReason)
+		}
+	}
+
+	if len(e.StoppedTasks) > 0 {
+		b.WriteString("\n\nStopped tasks:")
+
+		for _, task := range e.StoppedTasks {
+			fmt.Fprintf(&b, "\n  - %s: %s", aws.StringValue(task.TaskArn), aws.StringValue(task.StoppedReason))
+
+			for _, container := range task.Containers {
+				if container.ExitCode == nil && container.Reason == nil {
+					continue
+				}
+
+				fmt.Fprintf(&b, "\n    - container %s:", aws.StringValue(container.Name))
+
+				if container.ExitCode != nil {
+					fmt.Fprintf(&b, " exit code %d", aws.Int64Value(container.ExitCode))
+				}
+
+				if container.Reason != nil {
+					fmt.Fprintf(&b, " %s", aws.StringValue(container.Reason))
+				}
+			}
+		}
+	}
+
+	if len(e.Events) > 0 {
+		b.WriteString("\n\nRecent service events:")
+
+		for _, event := range e.Events {
+			fmt.Fprintf(&b, "\n  - %s %s", aws.TimeValue(event.CreatedAt).UTC().Format(time.RFC3339), aws.StringValue(event.Message))
+		}
+	}
+
+	return b.String()
+}
+
+func (e *ServiceDeploymentError) Unwrap() error {
+	return e.err
+}
+
+// newServiceDeploymentError gathers the diagnostics of a Service Deployment.
+// Failures to gather them are logged, as the wait error is more relevant.
+func newServiceDeploymentError(conn *ecs.ECS, cluster, service, deploymentID string, deployment *ecs.Deployment, err error) *ServiceDeploymentError {
+	e := &ServiceDeploymentError{
+		DeploymentID: deploymentID,
+		err:          err,
+	}
+
+	if deployment != nil {
+		e.RolloutState = aws.StringValue(deployment.RolloutState)
+		e.RolloutStateReason = aws.StringValue(deployment.RolloutStateReason)
+	}
+
+	output, serviceErr := finder.ServiceByClusterAndID(conn, cluster, service)
+
+	if serviceErr != nil {
+		log.Printf("[WARN] Error reading ECS Service (%s) events: %s", service, serviceErr)
+	}
+
+	if output != nil {
+		// Events are returned most recent first.
+		for _, event := range output.Events {
+			if len(e.Events) == serviceDeploymentErrorMaxEvents {
+				break
+			}
+
+			if deployment != nil && deployment.CreatedAt != nil && aws.TimeValue(event.CreatedAt).Before(aws.TimeValue(deployment.CreatedAt)) {
+				break
+			}
+
+			e.Events = append(e.Events, event)
+		}
+	}
+
+	tasks, tasksErr := finder.StoppedTasksByStartedBy(conn, cluster, deploymentID, serviceDeploymentErrorMaxStoppedTasks)
+
+	if tasksErr != nil {
+		log.Printf("[WARN] Error reading ECS Service (%s) Deployment (%s) stopped tasks: %s", service, deploymentID, tasksErr)
+	}
+
+	e.StoppedTasks = tasks
+
+	return e
+}
@@ -0,0 +1,18 @@
+package elb
+
+import (
+	"fmt"
+	"strings"
+)
+
+const attachmentImportIDSeparator = "/"
+
+// AttachmentParseImportID parses an attachment import ID of the form elb-name/instance-id.
+func AttachmentParseImportID(id string) (string, string, error) {
+	parts := strings.Split(id, attachmentImportIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected elb-name"+attachmentImportIDSeparator+"instance-id", id)
+}
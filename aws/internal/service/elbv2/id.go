@@ -2,6 +2,7 @@ package elbv2
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -21,3 +22,34 @@ func ListenerCertificateParseID(id string) (string, string, error) {
 func ListenerCertificateCreateID(listenerArn, certificateArn string) string {
 	return strings.Join([]string{listenerArn, listenerCertificateIDSeparator, certificateArn}, "")
 }
+
+const targetGroupAttachmentImportIDSeparator = ","
+
+// TargetGroupAttachmentParseImportID parses a target group attachment import ID of the form
+// target-group-arn,target-id[,port[,availability-zone]].
+func TargetGroupAttachmentParseImportID(id string) (string, string, int, string, error) {
+	parts := strings.Split(id, targetGroupAttachmentImportIDSeparator)
+
+	if len(parts) >= 2 && len(parts) <= 4 && parts[0] != "" && parts[1] != "" {
+		var port int
+		var availabilityZone string
+
+		if len(parts) >= 3 && parts[2] != "" {
+			v, err := strconv.Atoi(parts[2])
+			if err != nil {
+				return "", "", 0, "", fmt.Errorf("unexpected port (%q) in ID (%q): %w", parts[2], id, err)
+			}
+			port = v
+		}
+
+		if len(parts) == 4 {
+			availabilityZone = parts[3]
+		}
+
+		return parts[0], parts[1], port, availabilityZone, nil
+	}
+
+	return "", "", 0, "",
+		fmt.Errorf("unexpected format for ID (%q), expected target-group-arn"+targetGroupAttachmentImportIDSeparator+
+			"target-id["+targetGroupAttachmentImportIDSeparator+"port["+targetGroupAttachmentImportIDSeparator+"availability-zone]]", id)
+}
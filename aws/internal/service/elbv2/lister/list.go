@@ -0,0 +1,3 @@
+//go:generate go run ../../../generators/listpages/main.go -function=DescribeListenerCertificates,DescribeRules -input-paginator=Marker -output-paginator=NextMarker -page-size-field=PageSize -page-size=400 github.com/aws/aws-sdk-go/service/elbv2
+
+package lister
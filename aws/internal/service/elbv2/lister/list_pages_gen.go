@@ -0,0 +1,60 @@
+// Code generated by "aws/internal/generators/listpages/main.go -function=DescribeListenerCertificates,DescribeRules -input-paginator=Marker -output-paginator=NextMarker -page-size-field=PageSize -page-size=400 github.com/aws/aws-sdk-go/service/elbv2"; DO NOT EDIT.
+
+package lister
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+func DescribeListenerCertificatesPages(conn *elbv2.ELBV2, input *elbv2.DescribeListenerCertificatesInput, fn func(*elbv2.DescribeListenerCertificatesOutput, bool) bool) error {
+	return DescribeListenerCertificatesPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func DescribeListenerCertificatesPagesWithContext(ctx context.Context, conn *elbv2.ELBV2, input *elbv2.DescribeListenerCertificatesInput, fn func(*elbv2.DescribeListenerCertificatesOutput, bool) bool) error {
+	if input.PageSize == nil {
+		input.PageSize = aws.Int64(400)
+	}
+
+	for {
+		output, err := conn.DescribeListenerCertificatesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.Marker = output.NextMarker
+	}
+	return nil
+}
+
+func DescribeRulesPages(conn *elbv2.ELBV2, input *elbv2.DescribeRulesInput, fn func(*elbv2.DescribeRulesOutput, bool) bool) error {
+	return DescribeRulesPagesWithContext(context.Background(), conn, input, fn)
+}
+
+func DescribeRulesPagesWithContext(ctx context.Context, conn *elbv2.ELBV2, input *elbv2.DescribeRulesInput, fn func(*elbv2.DescribeRulesOutput, bool) bool) error {
+	if input.PageSize == nil {
+		input.PageSize = aws.Int64(400)
+	}
+
+	for {
+		output, err := conn.DescribeRulesWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextMarker) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.Marker = output.NextMarker
+	}
+	return nil
+}
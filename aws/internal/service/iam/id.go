@@ -0,0 +1,30 @@
+package iam
+
+import (
+	"fmt"
+	"strings"
+)
+
+const groupMembershipImportIDSeparator = "/"
+
+// GroupMembershipParseImportID parses a group membership import ID of the form name/group-name.
+// Group names cannot contain the separator, so the name may.
+func GroupMembershipParseImportID(id string) (string, string, error) {
+	if i := strings.LastIndex(id, groupMembershipImportIDSeparator); i > 0 && i < len(id)-1 {
+		return id[:i], id[i+1:], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected name"+groupMembershipImportIDSeparator+"group-name", id)
+}
+
+const policyAttachmentImportIDSeparator = "/"
+
+// PolicyAttachmentParseImportID parses a policy attachment import ID of the form name/policy-arn.
+// Policy ARNs contain the separator, so the ID is split before the ARN prefix.
+func PolicyAttachmentParseImportID(id string) (string, string, error) {
+	if i := strings.Index(id, policyAttachmentImportIDSeparator+"arn:"); i > 0 {
+		return id[:i], id[i+1:], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected name"+policyAttachmentImportIDSeparator+"policy-arn", id)
+}
@@ -0,0 +1,53 @@
+package lakeformation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+)
+
+// TableWildcard is the table name used in permissions import IDs for all tables in a database.
+const TableWildcard = "*"
+
+const permissionsImportIDSeparator = ","
+
+// permissionsImportIDResourceNames is the number of resource names expected in a permissions import ID
+// for each resource type.
+var permissionsImportIDResourceNames = map[string]int{
+	lakeformation.DataLakeResourceTypeCatalog:      0,
+	lakeformation.DataLakeResourceTypeDataLocation: 1,
+	lakeformation.DataLakeResourceTypeDatabase:     1,
+	lakeformation.DataLakeResourceTypeTable:        2,
+}
+
+// PermissionsParseImportID parses a permissions import ID of the form principal,resource-type[,resource-name...],
+// returning the principal, resource type and resource names. The resource names are none for CATALOG,
+// the ARN for DATA_LOCATION, the database name for DATABASE and the database and table names for TABLE.
+func PermissionsParseImportID(id string) (string, string, []string, error) {
+	parts := strings.Split(id, permissionsImportIDSeparator)
+
+	if len(parts) >= 2 && parts[0] != "" {
+		names := parts[2:]
+
+		if n, ok := permissionsImportIDResourceNames[parts[1]]; ok && n == len(names) && !hasEmptyString(names) {
+			return parts[0], parts[1], names, nil
+		}
+	}
+
+	return "", "", nil,
+		fmt.Errorf("unexpected format for ID (%q), expected principal"+permissionsImportIDSeparator+"CATALOG, principal"+
+			permissionsImportIDSeparator+"DATA_LOCATION"+permissionsImportIDSeparator+"resource-arn, principal"+
+			permissionsImportIDSeparator+"DATABASE"+permissionsImportIDSeparator+"database-name or principal"+
+			permissionsImportIDSeparator+"TABLE"+permissionsImportIDSeparator+"database-name"+permissionsImportIDSeparator+"table-name", id)
+}
+
+func hasEmptyString(values []string) bool {
+	for _, v := range values {
+		if v == "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,3 @@
+//go:generate go run ../../../generators/waiter/main.go -spec=spec.json github.com/aws/aws-sdk-go/service/qldb
+
+package waiter
@@ -0,0 +1,27 @@
+package s3
+
+import (
+	"fmt"
+	"strings"
+)
+
+const bucketObjectImportIDSeparator = "/"
+
+// BucketObjectParseImportID parses an object import ID of the form bucket/key,
+// optionally prefixed with s3://.
+// Object keys may themselves contain the separator.
+func BucketObjectParseImportID(id string) (string, string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(id, "s3://"), bucketObjectImportIDSeparator, 2)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%q), expected bucket"+bucketObjectImportIDSeparator+"key or s3://bucket"+bucketObjectImportIDSeparator+"key", id)
+}
+
+const bucketObjectsSyncIDSeparator = ","
+
+// BucketObjectsSyncCreateID returns the ID of a directory synchronized to a key prefix of a bucket.
+func BucketObjectsSyncCreateID(bucket, keyPrefix string) string {
+	return strings.Join([]string{bucket, keyPrefix}, bucketObjectsSyncIDSeparator)
+}
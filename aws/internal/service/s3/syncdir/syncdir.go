@@ -0,0 +1,292 @@
+// Package syncdir builds manifests of local directory trees for synchronization
+// to an S3 bucket prefix, so that differences can be computed without calling
+// the S3 API for every object.
+package syncdir
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DefaultContentType is used when the content type of a file can be
+// determined neither from its extension nor from its content.
+const DefaultContentType = "application/octet-stream"
+
+// Rule sets object attributes for files matching a glob pattern.
+// Empty attributes are left to earlier rules or to detection.
+type Rule struct {
+	Pattern            string
+	CacheControl       string
+	ContentDisposition string
+	ContentEncoding    string
+	ContentType        string
+	Metadata           map[string]string
+}
+
+// Options configures a directory scan.
+type Options struct {
+	// KeyPrefix is prepended to the slash-separated path of each file relative to the source directory.
+	KeyPrefix string
+
+	// Exclude lists glob patterns of files to skip.
+	Exclude []string
+
+	// Rules are applied in order to every file they match, later rules taking precedence.
+	Rules []Rule
+
+	// DefaultContentType overrides content type detection for files not matched by a rule setting one.
+	DefaultContentType string
+}
+
+// File is a local file to be uploaded as an S3 object.
+type File struct {
+	Key                string
+	Path               string
+	CacheControl       string
+	ContentDisposition string
+	ContentEncoding    string
+	ContentType        string
+	Metadata           map[string]string
+
+	contentHash string
+}
+
+// Hash returns a digest of the file content and of the object attributes,
+// so that a change to either results in a new upload.
+func (f *File) Hash() string {
+	h := sha256.New()
+
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\x00%s\x00", f.contentHash, f.CacheControl, f.ContentDisposition, f.ContentEncoding, f.ContentType)
+
+	keys := make([]string, 0, len(f.Metadata))
+	for k := range f.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%s\x00", k, f.Metadata[k])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Scan walks dir and returns the files to upload, keyed by object key.
+// Symbolic links to files are followed, directories are not.
+func Scan(dir string, opts Options) (map[string]*File, error) {
+	files := make(map[string]*File)
+
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		for _, pattern := range opts.Exclude {
+			if Match(pattern, rel) {
+				return nil
+			}
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			target, err := os.Stat(p)
+
+			if err != nil {
+				return err
+			}
+
+			if target.IsDir() {
+				return nil
+			}
+		}
+
+		file, err := newFile(p, rel, opts)
+
+		if err != nil {
+			return err
+		}
+
+		files[file.Key] = file
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error scanning directory (%s): %w", dir, err)
+	}
+
+	return files, nil
+}
+
+// Manifest returns the hash of each file, keyed by object key.
+func Manifest(files map[string]*File) map[string]string {
+	m := make(map[string]string, len(files))
+
+	for k, f := range files {
+		m[k] = f.Hash()
+	}
+
+	return m
+}
+
+// Diff compares two manifests and returns the sorted keys to upload
+// (new or changed) and to delete (no longer present).
+func Diff(old, new map[string]string) (upload []string, remove []string) {
+	for k, v := range new {
+		if ov, ok := old[k]; !ok || ov != v {
+			upload = append(upload, k)
+		}
+	}
+
+	for k := range old {
+		if _, ok := new[k]; !ok {
+			remove = append(remove, k)
+		}
+	}
+
+	sort.Strings(upload)
+	sort.Strings(remove)
+
+	return upload, remove
+}
+
+// Match reports whether a slash-separated relative path matches a glob pattern.
+// Patterns use path.Match syntax with the addition of "**", which matches any
+// number of path segments. A pattern without a slash matches the file name in
+// any directory.
+func Match(pattern, name string) bool {
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(name))
+		return ok
+	}
+
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
+
+func newFile(p, rel string, opts Options) (*File, error) {
+	f, err := os.Open(p)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	h := sha256.New()
+	sniff := make([]byte, 512)
+	n, err := io.ReadFull(f, sniff)
+
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+
+	sniff = sniff[:n]
+	h.Write(sniff)
+
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+
+	file := &File{
+		Key:         opts.KeyPrefix + rel,
+		Path:        p,
+		Metadata:    make(map[string]string),
+		contentHash: hex.EncodeToString(h.Sum(nil)),
+	}
+
+	for _, rule := range opts.Rules {
+		if !Match(rule.Pattern, rel) {
+			continue
+		}
+
+		if rule.CacheControl != "" {
+			file.CacheControl = rule.CacheControl
+		}
+
+		if rule.ContentDisposition != "" {
+			file.ContentDisposition = rule.ContentDisposition
+		}
+
+		if rule.ContentEncoding != "" {
+			file.ContentEncoding = rule.ContentEncoding
+		}
+
+		if rule.ContentType != "" {
+			file.ContentType = rule.ContentType
+		}
+
+		for k, v := range rule.Metadata {
+			file.Metadata[k] = v
+		}
+	}
+
+	if file.ContentType == "" {
+		file.ContentType = detectContentType(rel, sniff, opts.DefaultContentType)
+	}
+
+	return file, nil
+}
+
+// detectContentType determines a content type from the file extension,
+// falling back to the configured default and then to content sniffing.
+func detectContentType(name string, content []byte, defaultContentType string) string {
+	if v := mime.TypeByExtension(path.Ext(name)); v != "" {
+		return v
+	}
+
+	if defaultContentType != "" {
+		return defaultContentType
+	}
+
+	if len(content) == 0 {
+		return DefaultContentType
+	}
+
+	return http.DetectContentType(content)
+}
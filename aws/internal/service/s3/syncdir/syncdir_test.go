@@ -0,0 +1,150 @@
+package syncdir
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", true},
+		{"*.html", "index.htm", false},
+		{"docs/*.html", "docs/index.html", true},
+		{"docs/*.html", "docs/api/index.html", false},
+		{"docs/**/*.html", "docs/index.html", true},
+		{"docs/**/*.html", "docs/api/v1/index.html", true},
+		{"docs/**", "docs/api/v1/index.html", true},
+		{"docs/**", "assets/app.js", false},
+		{"**/.DS_Store", ".DS_Store", true},
+		{"**/.DS_Store", "a/b/.DS_Store", true},
+		{"assets/[ab]*.js", "assets/app.js", true},
+		{"assets/[ab]*.js", "assets/main.js", false},
+	}
+
+	for _, testCase := range testCases {
+		if got := Match(testCase.pattern, testCase.name); got != testCase.want {
+			t.Errorf("Match(%q, %q) = %t, want %t", testCase.pattern, testCase.name, got, testCase.want)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	dir := t.TempDir()
+
+	for name, content := range map[string]string{
+		"index.html":       "<html></html>",
+		"assets/app.js":    "console.log(1)",
+		"assets/logo":      "\x89PNG\r\n\x1a\n",
+		"assets/.DS_Store": "junk",
+		"empty":            "",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := Scan(dir, Options{
+		KeyPrefix: "site/",
+		Exclude:   []string{".DS_Store"},
+		Rules: []Rule{
+			{Pattern: "**", CacheControl: "max-age=300", Metadata: map[string]string{"team": "web"}},
+			{Pattern: "assets/**", CacheControl: "max-age=31536000", Metadata: map[string]string{"immutable": "true"}},
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var keys []string
+	for k := range files {
+		keys = append(keys, k)
+	}
+
+	if got, want := len(keys), 4; got != want {
+		t.Fatalf("got %d files (%v), want %d", got, keys, want)
+	}
+
+	testCases := []struct {
+		key          string
+		cacheControl string
+		contentType  string
+		metadata     map[string]string
+	}{
+		{"site/index.html", "max-age=300", "text/html; charset=utf-8", map[string]string{"team": "web"}},
+		{"site/assets/app.js", "max-age=31536000", "", map[string]string{"team": "web", "immutable": "true"}},
+		{"site/assets/logo", "max-age=31536000", "image/png", map[string]string{"team": "web", "immutable": "true"}},
+		{"site/empty", "max-age=300", DefaultContentType, map[string]string{"team": "web"}},
+	}
+
+	for _, testCase := range testCases {
+		f, ok := files[testCase.key]
+
+		if !ok {
+			t.Errorf("missing file %q", testCase.key)
+			continue
+		}
+
+		if f.CacheControl != testCase.cacheControl {
+			t.Errorf("%s: got cache control %q, want %q", testCase.key, f.CacheControl, testCase.cacheControl)
+		}
+
+		// Extension-based types depend on the host MIME tables, only check sniffed and fixed types.
+		if testCase.contentType != "" && f.ContentType != testCase.contentType {
+			t.Errorf("%s: got content type %q, want %q", testCase.key, f.ContentType, testCase.contentType)
+		}
+
+		if !reflect.DeepEqual(f.Metadata, testCase.metadata) {
+			t.Errorf("%s: got metadata %v, want %v", testCase.key, f.Metadata, testCase.metadata)
+		}
+	}
+}
+
+func TestFileHash(t *testing.T) {
+	f1 := &File{contentHash: "abc", ContentType: "text/plain", Metadata: map[string]string{"a": "1", "b": "2"}}
+	f2 := &File{contentHash: "abc", ContentType: "text/plain", Metadata: map[string]string{"b": "2", "a": "1"}}
+
+	if f1.Hash() != f2.Hash() {
+		t.Error("expected equal hashes for equal files")
+	}
+
+	f2.CacheControl = "no-cache"
+
+	if f1.Hash() == f2.Hash() {
+		t.Error("expected different hashes when attributes differ")
+	}
+
+	f2 = &File{contentHash: "abd", ContentType: "text/plain", Metadata: map[string]string{"a": "1", "b": "2"}}
+
+	if f1.Hash() == f2.Hash() {
+		t.Error("expected different hashes when content differs")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := map[string]string{"a": "1", "b": "2", "c": "3"}
+	new := map[string]string{"a": "1", "b": "20", "d": "4"}
+
+	upload, remove := Diff(old, new)
+
+	if want := []string{"b", "d"}; !reflect.DeepEqual(upload, want) {
+		t.Errorf("got upload %v, want %v", upload, want)
+	}
+
+	if want := []string{"c"}; !reflect.DeepEqual(remove, want) {
+		t.Errorf("got remove %v, want %v", remove, want)
+	}
+}
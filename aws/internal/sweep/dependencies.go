@@ -0,0 +1,132 @@
+package sweep
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// referenceSuffixes are the suffixes of attributes referencing another resource.
+var referenceSuffixes = []string{
+	"_arns",
+	"_ids",
+	"_names",
+	"_arn",
+	"_id",
+	"_name",
+}
+
+// DeriveDependencies returns the dependencies of each sweeper derived from the
+// resource schemas. A resource type referencing another resource type, e.g.
+// aws_subnet through its vpc_id attribute, must be swept first, so the
+// sweeper of the referenced type depends on the sweeper of the referencing type.
+//
+// A top-level attribute references a swept resource type when, after removing
+// an _id, _arn or _name suffix, it names either the whole type without the aws_
+// prefix (vpc_id references aws_vpc) or the end of a type sharing the same
+// service prefix (event_bus_name in aws_cloudwatch_event_rule references
+// aws_cloudwatch_event_bus). Only sweepers in the given list are considered and
+// dependencies that would create a cycle with the sweeper dependencies are omitted.
+func DeriveDependencies(sweepers []*Sweeper, resources map[string]*schema.Resource) map[string][]string {
+	names := make([]string, 0, len(sweepers))
+	graph := make(map[string]map[string]bool, len(sweepers))
+
+	for _, s := range sweepers {
+		names = append(names, s.Name)
+		graph[s.Name] = make(map[string]bool)
+
+		for _, dependency := range s.Dependencies {
+			graph[s.Name][dependency] = true
+		}
+	}
+
+	sort.Strings(names)
+
+	result := make(map[string][]string)
+
+	for _, name := range names {
+		r, ok := resources[name]
+		if !ok {
+			continue
+		}
+
+		keys := make([]string, 0, len(r.Schema))
+		for key := range r.Schema {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			for _, referenced := range names {
+				if referenced == name || graph[referenced][name] || !references(name, key, referenced) {
+					continue
+				}
+
+				// Skip dependencies creating a cycle, e.g. between resource types referencing each other.
+				if reachable(graph, name, referenced) {
+					continue
+				}
+
+				graph[referenced][name] = true
+				result[referenced] = append(result[referenced], name)
+			}
+		}
+	}
+
+	for _, dependencies := range result {
+		sort.Strings(dependencies)
+	}
+
+	return result
+}
+
+// reachable reports whether the sweeper from depends, directly or indirectly, on the sweeper to.
+func reachable(graph map[string]map[string]bool, from, to string) bool {
+	visited := make(map[string]bool)
+	stack := []string{from}
+
+	for len(stack) > 0 {
+		name := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if name == to {
+			return true
+		}
+
+		if visited[name] {
+			continue
+		}
+		visited[name] = true
+
+		for dependency := range graph[name] {
+			stack = append(stack, dependency)
+		}
+	}
+
+	return false
+}
+
+// references reports whether the attribute key of the resource type references the other resource type.
+func references(resourceType, key, referenced string) bool {
+	stem := key
+	for _, suffix := range referenceSuffixes {
+		if strings.HasSuffix(key, suffix) {
+			stem = strings.TrimSuffix(key, suffix)
+			break
+		}
+	}
+
+	if referenced == "aws_"+stem {
+		return true
+	}
+
+	return servicePrefix(resourceType) == servicePrefix(referenced) && strings.HasSuffix(referenced, "_"+stem)
+}
+
+// servicePrefix returns the first part of a resource type after aws_, e.g. cloudwatch for aws_cloudwatch_event_bus.
+func servicePrefix(resourceType string) string {
+	parts := strings.SplitN(strings.TrimPrefix(resourceType, "aws_"), "_", 2)
+
+	return parts[0]
+}
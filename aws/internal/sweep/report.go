@@ -0,0 +1,126 @@
+package sweep
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Status is the outcome of sweeping a resource.
+type Status string
+
+const (
+	StatusDeleted Status = "deleted"
+	StatusDryRun  Status = "dry run"
+	StatusFailed  Status = "failed"
+	StatusSkipped Status = "skipped"
+)
+
+// ReportEntry is a resource found by a sweeper.
+type ReportEntry struct {
+	Region  string
+	Service string
+	Type    string
+	ID      string
+	Status  Status
+	Err     error
+}
+
+// Report records the resources found by sweepers during a run. Resources found
+// by sweepers were leaked by acceptance tests. A nil Report records nothing.
+type Report struct {
+	lock    sync.Mutex
+	entries []ReportEntry
+}
+
+func (r *Report) add(region string, s *Sweeper, resource *Resource, status Status, err error) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.entries = append(r.entries, ReportEntry{
+		Region:  region,
+		Service: s.Service,
+		Type:    s.Name,
+		ID:      resource.ID,
+		Status:  status,
+		Err:     err,
+	})
+}
+
+// Entries returns the recorded entries grouped by service, then sorted by
+// Region, resource type and ID.
+func (r *Report) Entries() []ReportEntry {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.Lock()
+	entries := append([]ReportEntry(nil), r.entries...)
+	r.lock.Unlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+
+		if a.Service != b.Service {
+			return a.Service < b.Service
+		}
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.ID < b.ID
+	})
+
+	return entries
+}
+
+// Write writes the recorded entries grouped by service in a human readable format.
+func (r *Report) Write(w io.Writer) error {
+	entries := r.Entries()
+
+	if _, err := fmt.Fprintf(w, "Leaked resources: %d\n", len(entries)); err != nil {
+		return err
+	}
+
+	for i, entry := range entries {
+		if i == 0 || entries[i-1].Service != entry.Service {
+			n := 0
+			for _, e := range entries[i:] {
+				if e.Service != entry.Service {
+					break
+				}
+				n++
+			}
+
+			if _, err := fmt.Fprintf(w, "\n%s (%d)\n", serviceOrUnknown(entry.Service), n); err != nil {
+				return err
+			}
+		}
+
+		line := fmt.Sprintf("\t%s\t%s\t%s\t%s", entry.Region, entry.Type, entry.ID, entry.Status)
+		if entry.Err != nil {
+			line += fmt.Sprintf(": %s", entry.Err)
+		}
+
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func serviceOrUnknown(service string) string {
+	if service == "" {
+		return "(unknown service)"
+	}
+
+	return service
+}
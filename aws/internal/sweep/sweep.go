@@ -0,0 +1,166 @@
+package sweep
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const (
+	// DefaultParallelism is the default number of concurrent deletions per sweeper.
+	DefaultParallelism = 10
+)
+
+// TestNamePrefixes are the name prefixes of resources created by acceptance tests.
+var TestNamePrefixes = []string{
+	"tf-acc-test",
+	"tf_acc_test",
+}
+
+// Resource is a resource found by a sweeper.
+type Resource struct {
+	// ID identifies the resource in logs and reports.
+	ID string
+
+	// Name is matched against the sweeper name prefixes. Defaults to ID.
+	Name string
+
+	// Delete deletes the resource and waits for the deletion if necessary.
+	Delete func() error
+}
+
+// Sweeper declares how to list and delete the resources of a resource type.
+type Sweeper struct {
+	// Name is the Terraform resource type, e.g. aws_cloudwatch_event_bus.
+	Name string
+
+	// Service groups the resources in the report, e.g. cloudwatchevents.
+	Service string
+
+	// Dependencies are the sweepers run before this one, in addition to
+	// those returned by DeriveDependencies.
+	Dependencies []string
+
+	// NamePrefixes, when not empty, restricts deletion to resources whose name
+	// starts with one of the prefixes, e.g. TestNamePrefixes.
+	NamePrefixes []string
+
+	// List returns the resources in the Region.
+	List func(region string) ([]*Resource, error)
+}
+
+// Options configures a sweeper run.
+type Options struct {
+	// DryRun reports the resources that would be deleted without deleting them.
+	DryRun bool
+
+	// Parallelism is the maximum number of concurrent deletions.
+	// Defaults to DefaultParallelism.
+	Parallelism int
+
+	// Report, if set, records the resources found.
+	Report *Report
+
+	// SkipSweepError reports whether a List error, such as an unsupported
+	// API in the Region, skips the sweeper instead of failing it.
+	SkipSweepError func(error) bool
+
+	// SkipResourceError reports whether a Delete error, such as an access
+	// denied error, is ignored instead of failing the sweeper.
+	SkipResourceError func(error) bool
+}
+
+// Sweep lists the resources in the Region and deletes those matching the name
+// prefixes, or only reports them in dry run mode.
+func (s *Sweeper) Sweep(region string, opts Options) error {
+	resources, err := s.List(region)
+
+	if opts.SkipSweepError != nil && opts.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping %s sweep for %s: %s", s.Name, region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing %s resources (%s): %w", s.Name, region, err)
+	}
+
+	parallelism := opts.Parallelism
+	if parallelism < 1 {
+		parallelism = DefaultParallelism
+	}
+
+	var errs *multierror.Error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallelism)
+
+	for _, r := range resources {
+		if !s.matchesNamePrefixes(r) {
+			log.Printf("[DEBUG] Skipping %s (%s): name does not match sweeper name prefixes", s.Name, r.ID)
+			continue
+		}
+
+		if opts.DryRun {
+			log.Printf("[INFO] Would delete %s (%s) (dry run)", s.Name, r.ID)
+			opts.Report.add(region, s, r, StatusDryRun, nil)
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(r *Resource) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			log.Printf("[INFO] Deleting %s (%s)", s.Name, r.ID)
+			err := r.Delete()
+
+			if err != nil && opts.SkipResourceError != nil && opts.SkipResourceError(err) {
+				log.Printf("[WARN] Skipping %s (%s): %s", s.Name, r.ID, err)
+				opts.Report.add(region, s, r, StatusSkipped, err)
+				return
+			}
+
+			if err != nil {
+				err = fmt.Errorf("error deleting %s (%s): %w", s.Name, r.ID, err)
+				log.Printf("[ERROR] %s", err)
+				opts.Report.add(region, s, r, StatusFailed, err)
+
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+
+				return
+			}
+
+			opts.Report.add(region, s, r, StatusDeleted, nil)
+		}(r)
+	}
+
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+func (s *Sweeper) matchesNamePrefixes(r *Resource) bool {
+	if len(s.NamePrefixes) == 0 {
+		return true
+	}
+
+	name := r.Name
+	if name == "" {
+		name = r.ID
+	}
+
+	for _, prefix := range s.NamePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
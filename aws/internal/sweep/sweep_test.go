@@ -0,0 +1,215 @@
+package sweep
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSweeperSweep(t *testing.T) {
+	var lock sync.Mutex
+	var deleted []string
+	var running, maxRunning int32
+
+	deleteFunc := func(id string) func() error {
+		return func() error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+
+			lock.Lock()
+			if n > maxRunning {
+				maxRunning = n
+			}
+			deleted = append(deleted, id)
+			lock.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			if id == "tf-acc-test-fail" {
+				return errors.New("test")
+			}
+
+			return nil
+		}
+	}
+
+	sweeper := &Sweeper{
+		Name:         "aws_example_thing",
+		Service:      "example",
+		NamePrefixes: TestNamePrefixes,
+		List: func(region string) ([]*Resource, error) {
+			var resources []*Resource
+
+			for _, id := range []string{"tf-acc-test-1", "tf-acc-test-2", "tf-acc-test-3", "tf-acc-test-4", "tf-acc-test-fail", "production"} {
+				resources = append(resources, &Resource{ID: id, Delete: deleteFunc(id)})
+			}
+
+			return resources, nil
+		},
+	}
+
+	report := &Report{}
+	err := sweeper.Sweep("us-west-2", Options{Parallelism: 2, Report: report})
+
+	if err == nil || !strings.Contains(err.Error(), "tf-acc-test-fail") {
+		t.Errorf("expected deletion error, got: %v", err)
+	}
+
+	if got, want := len(deleted), 5; got != want {
+		t.Errorf("got %d deletions, want %d", got, want)
+	}
+
+	for _, id := range deleted {
+		if id == "production" {
+			t.Error("deleted resource not matching name prefixes")
+		}
+	}
+
+	if maxRunning > 2 {
+		t.Errorf("got %d concurrent deletions, want at most 2", maxRunning)
+	}
+
+	if got, want := len(report.Entries()), 5; got != want {
+		t.Errorf("got %d report entries, want %d", got, want)
+	}
+}
+
+func TestSweeperSweepDryRun(t *testing.T) {
+	sweeper := &Sweeper{
+		Name: "aws_example_thing",
+		List: func(region string) ([]*Resource, error) {
+			return []*Resource{{
+				ID: "test",
+				Delete: func() error {
+					t.Error("unexpected deletion in dry run")
+					return nil
+				},
+			}}, nil
+		},
+	}
+
+	report := &Report{}
+
+	if err := sweeper.Sweep("us-west-2", Options{DryRun: true, Report: report}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	entries := report.Entries()
+
+	if len(entries) != 1 || entries[0].Status != StatusDryRun {
+		t.Errorf("unexpected report entries: %v", entries)
+	}
+}
+
+func TestSweeperSweepSkipErrors(t *testing.T) {
+	errSkip := errors.New("skip")
+	skip := func(err error) bool { return errors.Is(err, errSkip) }
+
+	sweeper := &Sweeper{
+		Name: "aws_example_thing",
+		List: func(region string) ([]*Resource, error) {
+			return nil, errSkip
+		},
+	}
+
+	if err := sweeper.Sweep("us-west-2", Options{SkipSweepError: skip}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := sweeper.Sweep("us-west-2", Options{}); err == nil {
+		t.Error("expected error")
+	}
+
+	sweeper.List = func(region string) ([]*Resource, error) {
+		return []*Resource{{ID: "test", Delete: func() error { return errSkip }}}, nil
+	}
+	report := &Report{}
+
+	if err := sweeper.Sweep("us-west-2", Options{SkipResourceError: skip, Report: report}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if entries := report.Entries(); len(entries) != 1 || entries[0].Status != StatusSkipped {
+		t.Errorf("unexpected report entries: %v", entries)
+	}
+}
+
+func TestReportWrite(t *testing.T) {
+	report := &Report{}
+	thing := &Sweeper{Name: "aws_example_thing", Service: "example"}
+	widget := &Sweeper{Name: "aws_other_widget", Service: "other"}
+
+	report.add("us-west-2", widget, &Resource{ID: "w1"}, StatusDeleted, nil)
+	report.add("us-west-2", thing, &Resource{ID: "t2"}, StatusFailed, errors.New("test"))
+	report.add("us-east-1", thing, &Resource{ID: "t1"}, StatusDeleted, nil)
+
+	var b strings.Builder
+
+	if err := report.Write(&b); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `Leaked resources: 3
+
+example (2)
+	us-east-1	aws_example_thing	t1	deleted
+	us-west-2	aws_example_thing	t2	failed: test
+
+other (1)
+	us-west-2	aws_other_widget	w1	deleted
+`
+
+	if got := b.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDeriveDependencies(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"aws_vpc": {Schema: map[string]*schema.Schema{
+			"cidr_block": {},
+		}},
+		"aws_subnet": {Schema: map[string]*schema.Schema{
+			"vpc_id": {},
+		}},
+		"aws_cloudwatch_event_bus": {Schema: map[string]*schema.Schema{
+			"name": {},
+		}},
+		"aws_cloudwatch_event_rule": {Schema: map[string]*schema.Schema{
+			"event_bus_name": {},
+		}},
+		"aws_cloudwatch_event_target": {Schema: map[string]*schema.Schema{
+			"event_bus_name": {},
+			"rule":           {},
+		}},
+		"aws_example_a": {Schema: map[string]*schema.Schema{
+			"b_id": {},
+		}},
+		"aws_example_b": {Schema: map[string]*schema.Schema{
+			"a_id": {},
+		}},
+	}
+
+	var sweepers []*Sweeper
+	for name := range resources {
+		sweepers = append(sweepers, &Sweeper{Name: name})
+	}
+
+	got := DeriveDependencies(sweepers, resources)
+	want := map[string][]string{
+		"aws_vpc":                   {"aws_subnet"},
+		"aws_cloudwatch_event_bus":  {"aws_cloudwatch_event_rule", "aws_cloudwatch_event_target"},
+		"aws_cloudwatch_event_rule": {"aws_cloudwatch_event_target"},
+		"aws_example_b":             {"aws_example_a"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
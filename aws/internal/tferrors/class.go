@@ -0,0 +1,187 @@
+package tferrors
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// Class is a classification of errors by how callers should handle them.
+type Class string
+
+const (
+	ClassUnknown             Class = ""
+	ClassAccessDenied        Class = "access denied"
+	ClassConflict            Class = "conflict"
+	ClassEventualConsistency Class = "eventual consistency"
+	ClassNotFound            Class = "not found"
+	ClassQuotaExceeded       Class = "quota exceeded"
+	ClassThrottled           Class = "throttled"
+)
+
+// Class_Values returns all error classes other than ClassUnknown.
+func Class_Values() []Class {
+	return []Class{
+		ClassAccessDenied,
+		ClassConflict,
+		ClassEventualConsistency,
+		ClassNotFound,
+		ClassQuotaExceeded,
+		ClassThrottled,
+	}
+}
+
+// TransientClasses are the classes of errors that usually resolve themselves
+// when the request is retried.
+var TransientClasses = []Class{
+	ClassConflict,
+	ClassEventualConsistency,
+	ClassThrottled,
+}
+
+// codeMessage matches AWS errors with the code and a message containing the message.
+type codeMessage struct {
+	code    string
+	message string
+}
+
+// eventualConsistencyErrors are errors returned while recently created or
+// modified dependencies, usually IAM roles and policies, propagate.
+var eventualConsistencyErrors = []codeMessage{
+	{"InvalidParameterException", "Misconfigured PodExecutionRole Trust Policy"},
+	{"InvalidParameterException", "Role could not be assumed because the trusted entity is not correct"},
+	{"InvalidParameterValue", "Invalid IAM Instance Profile"},
+	{"InvalidParameterValueException", "The provided execution role does not have permissions"},
+	{"InvalidParameterValueException", "The role defined for the function cannot be assumed by Lambda"},
+	{"ValidationException", "cannot be assumed by"},
+}
+
+var accessDeniedCodes = []string{
+	"AccessDenied",
+	"AccessDeniedException",
+	"AuthorizationError",
+	"Forbidden",
+	"UnauthorizedAccess",
+	"UnauthorizedOperation",
+}
+
+var conflictCodes = []string{
+	"ConcurrentModification",
+	"ConcurrentModificationException",
+	"ConflictException",
+	"DependencyViolation",
+	"IncorrectState",
+	"InvalidStateException",
+	"OperationAbortedException",
+	"OperationInProgressException",
+	"ResourceConflictException",
+	"ResourceInUse",
+	"ResourceInUseException",
+}
+
+var quotaExceededCodes = []string{
+	"LimitExceededException",
+	"QuotaExceededException",
+	"ServiceQuotaExceededException",
+	"TooManyTagsException",
+}
+
+var throttledCodes = []string{
+	"RequestLimitExceeded",
+	"RequestThrottled",
+	"RequestThrottledException",
+	"SlowDown",
+	"Throttled",
+	"ThrottledException",
+	"Throttling",
+	"ThrottlingException",
+	"TooManyRequestsException",
+}
+
+// Classify returns the class of the error, or ClassUnknown.
+// AWS errors are classified by their code, and message where the code is too generic,
+// falling back to the HTTP status code. Wrapped errors are classified.
+func Classify(err error) Class {
+	if err == nil {
+		return ClassUnknown
+	}
+
+	if tfresource.NotFound(err) {
+		return ClassNotFound
+	}
+
+	var awsErr awserr.Error
+
+	if !errors.As(err, &awsErr) {
+		return ClassUnknown
+	}
+
+	code := awsErr.Code()
+
+	for _, v := range eventualConsistencyErrors {
+		if code == v.code && strings.Contains(awsErr.Message(), v.message) {
+			return ClassEventualConsistency
+		}
+	}
+
+	switch {
+	case contains(throttledCodes, code) || request.IsErrorThrottle(awsErr):
+		return ClassThrottled
+	case strings.HasSuffix(code, "NotFound") || strings.HasSuffix(code, "NotFoundException") || strings.HasPrefix(code, "NoSuch"):
+		return ClassNotFound
+	case contains(quotaExceededCodes, code) || strings.HasSuffix(code, "LimitExceeded"):
+		return ClassQuotaExceeded
+	case contains(accessDeniedCodes, code):
+		return ClassAccessDenied
+	case contains(conflictCodes, code):
+		return ClassConflict
+	}
+
+	var reqErr awserr.RequestFailure
+
+	if errors.As(err, &reqErr) {
+		switch reqErr.StatusCode() {
+		case http.StatusForbidden:
+			return ClassAccessDenied
+		case http.StatusNotFound:
+			return ClassNotFound
+		case http.StatusConflict:
+			return ClassConflict
+		case http.StatusTooManyRequests:
+			return ClassThrottled
+		}
+	}
+
+	return ClassUnknown
+}
+
+// Is returns true if the error is of one of the classes.
+func Is(err error, classes ...Class) bool {
+	class := Classify(err)
+
+	if class == ClassUnknown {
+		return false
+	}
+
+	for _, v := range classes {
+		if class == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contains(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,123 @@
+package tferrors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestClassify(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected Class
+	}{
+		{
+			Name:     "nil error",
+			Err:      nil,
+			Expected: ClassUnknown,
+		},
+		{
+			Name:     "other error",
+			Err:      errors.New("test"),
+			Expected: ClassUnknown,
+		},
+		{
+			Name:     "not found error",
+			Err:      &resource.NotFoundError{LastError: errors.New("test")},
+			Expected: ClassNotFound,
+		},
+		{
+			Name:     "EC2 not found code",
+			Err:      awserr.New("InvalidVpcID.NotFound", "test", nil),
+			Expected: ClassNotFound,
+		},
+		{
+			Name:     "no such code",
+			Err:      awserr.New("NoSuchEntity", "test", nil),
+			Expected: ClassNotFound,
+		},
+		{
+			Name:     "not found status code",
+			Err:      awserr.NewRequestFailure(awserr.New("BadRequest", "test", nil), 404, "id"),
+			Expected: ClassNotFound,
+		},
+		{
+			Name:     "throttling code",
+			Err:      awserr.New("ThrottlingException", "test", nil),
+			Expected: ClassThrottled,
+		},
+		{
+			Name:     "request limit exceeded code",
+			Err:      awserr.New("RequestLimitExceeded", "test", nil),
+			Expected: ClassThrottled,
+		},
+		{
+			Name:     "too many requests status code",
+			Err:      awserr.NewRequestFailure(awserr.New("Unknown", "test", nil), 429, "id"),
+			Expected: ClassThrottled,
+		},
+		{
+			Name:     "conflict code",
+			Err:      awserr.New("ResourceInUseException", "test", nil),
+			Expected: ClassConflict,
+		},
+		{
+			Name:     "eventual consistency code and message",
+			Err:      awserr.New("InvalidParameterValueException", "The role defined for the function cannot be assumed by Lambda.", nil),
+			Expected: ClassEventualConsistency,
+		},
+		{
+			Name:     "eventual consistency code with other message",
+			Err:      awserr.New("InvalidParameterValueException", "test", nil),
+			Expected: ClassUnknown,
+		},
+		{
+			Name:     "access denied code",
+			Err:      awserr.New("UnauthorizedOperation", "test", nil),
+			Expected: ClassAccessDenied,
+		},
+		{
+			Name:     "access denied status code",
+			Err:      awserr.NewRequestFailure(awserr.New("Unknown", "test", nil), 403, "id"),
+			Expected: ClassAccessDenied,
+		},
+		{
+			Name:     "quota exceeded code",
+			Err:      awserr.New("VpcLimitExceeded", "test", nil),
+			Expected: ClassQuotaExceeded,
+		},
+		{
+			Name:     "wrapped quota exceeded code",
+			Err:      fmt.Errorf("test: %w", awserr.New("LimitExceededException", "test", nil)),
+			Expected: ClassQuotaExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got, want := Classify(testCase.Err), testCase.Expected; got != want {
+				t.Errorf("got %q, expected %q", got, want)
+			}
+		})
+	}
+}
+
+func TestIs(t *testing.T) {
+	err := awserr.New("ThrottlingException", "test", nil)
+
+	if !Is(err, ClassConflict, ClassThrottled) {
+		t.Error("expected throttling error to be of the classes")
+	}
+
+	if Is(err, ClassNotFound) {
+		t.Error("expected throttling error not to be of the class")
+	}
+
+	if Is(errors.New("test"), ClassUnknown) {
+		t.Error("expected unknown errors not to be of any class")
+	}
+}
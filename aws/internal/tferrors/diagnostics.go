@@ -0,0 +1,160 @@
+package tferrors
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// Error is an AWS API error with diagnostics: the API operation, request ID and
+// the address of the resource being managed.
+// It implements awserr.Error and awserr.RequestFailure, so existing error code checks are unaffected.
+type Error struct {
+	Err awserr.Error
+
+	// Operation is the API operation as an IAM action, e.g. ec2:CreateVpc.
+	Operation string
+	// Address is the resource type and ID, e.g. aws_vpc (vpc-12345678), see WithAddress.
+	Address string
+
+	requestID  string
+	statusCode int
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+
+	b.WriteString(e.Err.Error())
+
+	if e.statusCode == 0 && e.requestID != "" {
+		fmt.Fprintf(&b, "\n\trequest id: %s", e.requestID)
+	}
+
+	if e.Operation != "" {
+		fmt.Fprintf(&b, "\n\toperation: %s", e.Operation)
+	}
+
+	if action := e.MissingAction(); action != "" {
+		fmt.Fprintf(&b, "\n\tmissing IAM permission: %s", action)
+	}
+
+	return b.String()
+}
+
+// Code returns the AWS error code.
+func (e *Error) Code() string {
+	return e.Err.Code()
+}
+
+// Message returns the AWS error message.
+func (e *Error) Message() string {
+	return e.Err.Message()
+}
+
+// OrigErr returns the original error of the AWS error, if any.
+func (e *Error) OrigErr() error {
+	return e.Err.OrigErr()
+}
+
+// StatusCode returns the HTTP status code of the response.
+func (e *Error) StatusCode() int {
+	return e.statusCode
+}
+
+// RequestID returns the AWS request ID.
+func (e *Error) RequestID() string {
+	return e.requestID
+}
+
+// Unwrap returns the AWS error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Class returns the class of the error.
+func (e *Error) Class() Class {
+	return Classify(e.Err)
+}
+
+var missingActionRegexp = regexp.MustCompile(`not authorized to perform:? ([a-zA-Z0-9-]+:[a-zA-Z0-9*]+)`)
+
+// MissingAction returns the IAM action that was denied, from the error message
+// if available, otherwise the operation. It returns "" for other classes of errors.
+func (e *Error) MissingAction() string {
+	if e.Class() != ClassAccessDenied {
+		return ""
+	}
+
+	if m := missingActionRegexp.FindStringSubmatch(e.Message()); m != nil {
+		return m[1]
+	}
+
+	return e.Operation
+}
+
+// CompleteHandler attaches the operation and request ID to AWS API errors.
+// It must be added to the Complete handlers of the session.
+func CompleteHandler(r *request.Request) {
+	if r.Error == nil || errors.As(r.Error, new(*Error)) {
+		return
+	}
+
+	awsErr, ok := r.Error.(awserr.Error) // nolint:errorlint
+	if !ok {
+		return
+	}
+
+	e := &Error{
+		Err:       awsErr,
+		requestID: r.RequestID,
+	}
+
+	if reqErr, ok := awsErr.(awserr.RequestFailure); ok { // nolint:errorlint
+		e.statusCode = reqErr.StatusCode()
+		e.requestID = reqErr.RequestID()
+	}
+
+	if r.Operation != nil {
+		service := r.ClientInfo.SigningName
+		if service == "" {
+			service = r.ClientInfo.ServiceName
+		}
+
+		e.Operation = fmt.Sprintf("%s:%s", service, r.Operation.Name)
+	}
+
+	r.Error = e
+}
+
+// WithAddress attaches the resource address to an AWS API error, if any, in the error chain.
+// Errors without an AWS API error are returned unchanged.
+func WithAddress(err error, address string) error {
+	var e *Error
+
+	if !errors.As(err, &e) || e.Address != "" {
+		return err
+	}
+
+	e.Address = address
+
+	return &addressError{err: err, address: address}
+}
+
+// addressError adds the resource address to the message of an error chain,
+// whose messages are fixed once wrapped.
+type addressError struct {
+	err     error
+	address string
+}
+
+func (e *addressError) Error() string {
+	return fmt.Sprintf("%s\n\tresource: %s", e.err, e.address)
+}
+
+func (e *addressError) Unwrap() error {
+	return e.err
+}
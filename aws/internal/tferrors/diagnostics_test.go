@@ -0,0 +1,135 @@
+package tferrors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testRequest(err error) *request.Request {
+	return &request.Request{
+		ClientInfo: metadata.ClientInfo{ServiceName: "ec2"},
+		Operation:  &request.Operation{Name: "CreateVpc"},
+		Error:      err,
+	}
+}
+
+func TestCompleteHandler(t *testing.T) {
+	r := testRequest(awserr.NewRequestFailure(awserr.New("InvalidParameterValue", "test", nil), 400, "request-1"))
+
+	CompleteHandler(r)
+
+	var e *Error
+
+	if !errors.As(r.Error, &e) {
+		t.Fatalf("expected *Error, got %T", r.Error)
+	}
+
+	if got, want := e.Operation, "ec2:CreateVpc"; got != want {
+		t.Errorf("got operation %q, expected %q", got, want)
+	}
+
+	if got, want := e.RequestID(), "request-1"; got != want {
+		t.Errorf("got request ID %q, expected %q", got, want)
+	}
+
+	if !tfawserr.ErrMessageContains(r.Error, "InvalidParameterValue", "test") {
+		t.Errorf("expected error code checks to be unaffected, got %v", r.Error)
+	}
+
+	if _, ok := r.Error.(awserr.RequestFailure); !ok { // nolint:errorlint
+		t.Errorf("expected awserr.RequestFailure, got %T", r.Error)
+	}
+
+	CompleteHandler(r)
+
+	if r.Error != e { // nolint:errorlint
+		t.Error("expected error not to be wrapped twice")
+	}
+}
+
+func TestCompleteHandlerOtherError(t *testing.T) {
+	err := errors.New("test")
+	r := testRequest(err)
+
+	CompleteHandler(r)
+
+	if r.Error != err { // nolint:errorlint
+		t.Errorf("expected error to be unchanged, got %v", r.Error)
+	}
+}
+
+func TestErrorMissingAction(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      awserr.Error
+		Expected string
+	}{
+		{
+			Name:     "action in message",
+			Err:      awserr.New("AccessDenied", "User: arn:aws:iam::123456789012:user/test is not authorized to perform: iam:PassRole on resource: arn:aws:iam::123456789012:role/test", nil),
+			Expected: "iam:PassRole",
+		},
+		{
+			Name:     "action from operation",
+			Err:      awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil),
+			Expected: "ec2:CreateVpc",
+		},
+		{
+			Name:     "other class",
+			Err:      awserr.New("InvalidParameterValue", "test", nil),
+			Expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := testRequest(testCase.Err)
+
+			CompleteHandler(r)
+
+			e := r.Error.(*Error) // nolint:errorlint
+
+			if got, want := e.MissingAction(), testCase.Expected; got != want {
+				t.Errorf("got %q, expected %q", got, want)
+			}
+
+			if want := testCase.Expected; want != "" && !strings.Contains(e.Error(), "missing IAM permission: "+want) {
+				t.Errorf("expected error message to contain missing IAM permission, got %q", e.Error())
+			}
+		})
+	}
+}
+
+func TestWrapResource(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("vpc-12345678")
+
+			req := testRequest(awserr.New("VpcLimitExceeded", "test", nil))
+			CompleteHandler(req)
+
+			return fmt.Errorf("error creating VPC: %w", req.Error)
+		},
+	}
+
+	WrapResource("aws_vpc", r)
+
+	err := r.Create(r.Data(nil), nil)
+
+	if got, want := err.Error(), "resource: aws_vpc (vpc-12345678)"; !strings.Contains(got, want) {
+		t.Errorf("expected error %q to contain %q", got, want)
+	}
+
+	if got, want := Classify(err), ClassQuotaExceeded; got != want {
+		t.Errorf("got class %q, expected %q", got, want)
+	}
+}
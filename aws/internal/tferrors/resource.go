@@ -0,0 +1,38 @@
+package tferrors
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WrapResource attaches the resource address to AWS API errors returned by the
+// Create, Read, Update and Delete functions of the resource with the given name.
+// Context-aware functions return diagnostics without the error chain and are unchanged.
+func WrapResource(name string, r *schema.Resource) {
+	r.Create = wrapFunc(name, r.Create)
+	r.Read = wrapFunc(name, r.Read)
+	r.Update = wrapFunc(name, r.Update)
+	r.Delete = wrapFunc(name, r.Delete)
+}
+
+func wrapFunc(name string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+
+	return func(d *schema.ResourceData, meta interface{}) error {
+		err := f(d, meta)
+
+		if err == nil {
+			return nil
+		}
+
+		address := name
+		if id := d.Id(); id != "" {
+			address = fmt.Sprintf("%s (%s)", name, id)
+		}
+
+		return WithAddress(err, address)
+	}
+}
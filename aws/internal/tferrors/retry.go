@@ -0,0 +1,51 @@
+package tferrors
+
+import (
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// Retry calls f until it returns no error or an error not of one of the classes,
+// for at most the timeout, usually a resource timeout such as d.Timeout(schema.TimeoutCreate).
+// If the timeout elapses, f is called one last time.
+func Retry(timeout time.Duration, f func() (interface{}, error), classes ...Class) (interface{}, error) {
+	var output interface{}
+
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		var err error
+
+		output, err = f()
+
+		if Is(err, classes...) {
+			log.Printf("[DEBUG] Retrying %s error: %s", Classify(err), err)
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = f()
+	}
+
+	return output, err
+}
+
+// RetryTransient calls f until it returns no error or an error not of the TransientClasses,
+// for at most the timeout.
+func RetryTransient(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return Retry(timeout, f, TransientClasses...)
+}
+
+// RetryWhenEventuallyConsistent calls f until it returns no error or an error not of
+// ClassEventualConsistency, for at most the timeout.
+func RetryWhenEventuallyConsistent(timeout time.Duration, f func() (interface{}, error)) (interface{}, error) {
+	return Retry(timeout, f, ClassEventualConsistency)
+}
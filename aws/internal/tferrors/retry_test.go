@@ -0,0 +1,54 @@
+package tferrors
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestRetry(t *testing.T) {
+	calls := 0
+
+	output, err := Retry(time.Minute, func() (interface{}, error) {
+		calls++
+
+		if calls < 2 {
+			return nil, awserr.New("ConflictException", "test", nil)
+		}
+
+		return "output", nil
+	}, ClassConflict)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := output, "output"; got != want {
+		t.Errorf("got output %v, expected %v", got, want)
+	}
+
+	if got, want := calls, 2; got != want {
+		t.Errorf("got %d calls, expected %d", got, want)
+	}
+}
+
+func TestRetryOtherClass(t *testing.T) {
+	calls := 0
+	expectedErr := awserr.New("AccessDeniedException", "test", nil)
+
+	_, err := RetryTransient(time.Minute, func() (interface{}, error) {
+		calls++
+
+		return nil, expectedErr
+	})
+
+	if !errors.Is(err, expectedErr) {
+		t.Errorf("got error %v, expected %v", err, expectedErr)
+	}
+
+	if got, want := calls, 1; got != want {
+		t.Errorf("got %d calls, expected %d", got, want)
+	}
+}
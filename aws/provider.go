@@ -1,13 +1,22 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/apitrace"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/mutexkv"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/ratelimit"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/regionpolicy"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/resourcepolicy"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/retrypolicy"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tferrors"
 )
 
 // Provider returns a *schema.Provider.
@@ -73,6 +82,113 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 			},
 
+			"max_concurrent_operations": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeInt},
+				ValidateFunc: validateProviderMaxConcurrentOperations,
+				Description:  descriptions["max_concurrent_operations"],
+			},
+
+			"api_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["api_trace_file"],
+			},
+
+			"rate_limit": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Configuration blocks with client-side rate limits for AWS API requests to a service.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of requests sent at once.",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0.001),
+							Description:  "Sustained rate of requests per second.",
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "AWS SDK for Go service name, e.g. route53.",
+						},
+					},
+				},
+			},
+
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for retrying AWS API requests across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"jitter_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      retrypolicy.JitterModeFull,
+							ValidateFunc: validation.StringInSlice(retrypolicy.JitterMode_Values(), false),
+							Description:  "Randomization applied to the delay between attempts.",
+						},
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of attempts for each API request, including the initial request. Overrides max_retries.",
+						},
+						"max_backoff": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								value := v.(string)
+								duration, err := time.ParseDuration(value)
+								if err != nil {
+									errors = append(errors, fmt.Errorf(
+										"%q cannot be parsed as a duration: %s", k, err))
+								}
+								if duration <= 0 {
+									errors = append(errors, fmt.Errorf(
+										"%q must be greater than zero", k))
+								}
+								return
+							},
+							Description: "Maximum delay between attempts, e.g. 30s.",
+						},
+						"retryable_error_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Additional AWS error codes to retry for a service.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"error_codes": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										MinItems:    1,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "AWS error codes to retry.",
+									},
+									"service": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+										Description:  "AWS SDK for Go service name, e.g. ec2.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"allowed_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -89,6 +205,71 @@ func Provider() *schema.Provider {
 				Set:           schema.HashString,
 			},
 
+			"allowed_regions": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				ConflictsWith: []string{"forbidden_regions"},
+				Set:           schema.HashString,
+				Description:   descriptions["allowed_regions"],
+			},
+
+			"forbidden_regions": {
+				Type:          schema.TypeSet,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Optional:      true,
+				ConflictsWith: []string{"allowed_regions"},
+				Set:           schema.HashString,
+				Description:   descriptions["forbidden_regions"],
+			},
+
+			"allowed_partitions": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Set:         schema.HashString,
+				Description: descriptions["allowed_partitions"],
+			},
+
+			"policy_validation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      IAMPolicyValidationOff,
+				ValidateFunc: validation.StringInSlice(IAMPolicyValidation_Values(), false),
+				Description:  "Whether IAM policy documents are linted during plan, with findings reported as warnings or errors.",
+			},
+
+			"resource_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to restrict the resource types managed across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_resource_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateResourcePolicyPattern,
+							},
+							Set:         schema.HashString,
+							Description: "Resource type glob patterns, at least one of which a resource type must match.",
+						},
+						"denied_resource_types": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateResourcePolicyPattern,
+							},
+							Set:         schema.HashString,
+							Description: "Resource type glob patterns that are never permitted.",
+						},
+					},
+				},
+			},
+
 			"default_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -108,6 +289,71 @@ func Provider() *schema.Provider {
 
 			"endpoints": endpointsSchema(),
 
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with tag policy rules evaluated against resource tags across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Configuration block restricting the values of a tag.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Tag key.",
+									},
+									"values": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										MinItems:    1,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Allowed tag values.",
+									},
+								},
+							},
+						},
+						"enforcement": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      keyvaluetags.PolicyEnforcementError,
+							ValidateFunc: validation.StringInSlice(keyvaluetags.PolicyEnforcement_Values(), false),
+							Description:  "Whether tag policy violations are reported as errors or warnings.",
+						},
+						"key_case": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(keyvaluetags.KeyCase_Values(), false),
+							Description:  "Casing convention of tag keys.",
+						},
+						"max_key_length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of characters of tag keys.",
+						},
+						"max_value_length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of characters of tag values.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Tag keys required on all resources supporting tags.",
+						},
+					},
+				},
+			},
+
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -962,8 +1208,17 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_object":                                    resourceAwsS3BucketObject(),
 			"aws_s3_bucket_ownership_controls":                        resourceAwsS3BucketOwnershipControls(),
 			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
+			"aws_s3_bucket_objects_sync":                              resourceAwsS3BucketObjectsSync(),
 			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
 			"aws_s3_bucket_inventory":                                 resourceAwsS3BucketInventory(),
+			"aws_s3_bucket_acl":                                       resourceAwsS3BucketAcl(),
+			"aws_s3_bucket_cors_configuration":                        resourceAwsS3BucketCorsConfiguration(),
+			"aws_s3_bucket_lifecycle_configuration":                   resourceAwsS3BucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                                   resourceAwsS3BucketLogging(),
+			"aws_s3_bucket_replication_configuration":                 resourceAwsS3BucketReplicationConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration":      resourceAwsS3BucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                                resourceAwsS3BucketVersioning(),
+			"aws_s3_bucket_website_configuration":                     resourceAwsS3BucketWebsiteConfiguration(),
 			"aws_s3_object_copy":                                      resourceAwsS3ObjectCopy(),
 			"aws_s3control_bucket":                                    resourceAwsS3ControlBucket(),
 			"aws_s3control_bucket_policy":                             resourceAwsS3ControlBucketPolicy(),
@@ -1143,9 +1398,45 @@ func Provider() *schema.Provider {
 		return providerConfigure(d, terraformVersion)
 	}
 
+	// Record resource operation durations when API tracing is configured.
+	apiTracer := func(meta interface{}) *apitrace.Tracer {
+		if client, ok := meta.(*AWSClient); ok {
+			return client.apiTracer
+		}
+
+		return nil
+	}
+
+	for name, r := range provider.DataSourcesMap {
+		apitrace.WrapResource("data."+name, r, apiTracer)
+	}
+
+	// Reject planned resources of types not permitted by the resource policy.
+	resourcePolicy := func(meta interface{}) *resourcepolicy.Config {
+		if client, ok := meta.(*AWSClient); ok {
+			return client.resourcePolicy
+		}
+
+		return nil
+	}
+
+	for name, r := range provider.ResourcesMap {
+		apitrace.WrapResource(name, r, apiTracer)
+		tferrors.WrapResource(name, r)
+		wrapResourceTagPolicy(name, r)
+		wrapResourcePolicyValidation(name, r)
+		resourcepolicy.WrapResource(name, r, resourcePolicy)
+	}
+
 	return provider
 }
 
+// CloseAPITraces writes the summaries of and closes any open API trace files.
+// It should be called when the provider process is shutting down.
+func CloseAPITraces() {
+	apitrace.CloseAll()
+}
+
 var descriptions map[string]string
 var endpointServiceNames []string
 
@@ -1169,10 +1460,26 @@ func init() {
 		"token": "session token. A session token is only required if you are\n" +
 			"using temporary security credentials.",
 
+		"api_trace_file": "The path of a file to append AWS API call and resource operation\n" +
+			"trace events to, as JSON lines.",
+
+		"allowed_regions": "The only AWS Regions the provider and resources with\n" +
+			"explicit Region arguments may use.",
+
+		"forbidden_regions": "AWS Regions the provider and resources with explicit\n" +
+			"Region arguments must not use.",
+
+		"allowed_partitions": "The only AWS partitions, e.g. aws or aws-us-gov, the\n" +
+			"provider and resources with explicit Region arguments may use.",
+
 		"max_retries": "The maximum number of times an AWS API request is\n" +
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"max_concurrent_operations": "The maximum number of concurrent operations, including\n" +
+			"waiting for their completion, per service, e.g. eks, to stay within service\n" +
+			"concurrency quotas.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
@@ -1358,6 +1665,7 @@ func init() {
 func providerConfigure(d *schema.ResourceData, terraformVersion string) (interface{}, error) {
 	config := Config{
 		AccessKey:               d.Get("access_key").(string),
+		APITraceFile:            d.Get("api_trace_file").(string),
 		SecretKey:               d.Get("secret_key").(string),
 		Profile:                 d.Get("profile").(string),
 		Token:                   d.Get("token").(string),
@@ -1366,7 +1674,14 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		DefaultTagsConfig:       expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
+		MaxConcurrentOperations: expandProviderMaxConcurrentOperations(d.Get("max_concurrent_operations").(map[string]interface{})),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		RateLimits:              expandProviderRateLimits(d.Get("rate_limit").(*schema.Set).List()),
+		PolicyValidation:        d.Get("policy_validation").(string),
+		RegionPolicy:            expandProviderRegionPolicy(d),
+		ResourcePolicy:          expandProviderResourcePolicy(d.Get("resource_policy").([]interface{})),
+		RetryConfig:             expandProviderRetry(d.Get("retry").([]interface{})),
+		TagPolicyConfig:         expandProviderTagPolicy(d.Get("tag_policy").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
@@ -1378,67 +1693,44 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		m := l[0].(map[string]interface{})
+		// The first role is assumed with the source credentials, each subsequent
+		// role with the credentials of the role before it.
+		for i, tfMapRaw := range l {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
 
-		if v, ok := m["duration_seconds"].(int); ok && v != 0 {
-			config.AssumeRoleDurationSeconds = v
-		}
-
-		if v, ok := m["external_id"].(string); ok && v != "" {
-			config.AssumeRoleExternalID = v
-		}
-
-		if v, ok := m["policy"].(string); ok && v != "" {
-			config.AssumeRolePolicy = v
-		}
-
-		if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
-			for _, policyARNRaw := range policyARNSet.List() {
-				policyARN, ok := policyARNRaw.(string)
-
-				if !ok {
-					continue
-				}
-
-				config.AssumeRolePolicyARNs = append(config.AssumeRolePolicyARNs, policyARN)
+			if !ok {
+				return nil, fmt.Errorf("assume_role configuration block %d: empty configuration block", i+1)
 			}
-		}
-
-		if v, ok := m["role_arn"].(string); ok && v != "" {
-			config.AssumeRoleARN = v
-		}
-
-		if v, ok := m["session_name"].(string); ok && v != "" {
-			config.AssumeRoleSessionName = v
-		}
 
-		if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
-			config.AssumeRoleTags = make(map[string]string)
+			assumeRole := expandProviderAssumeRole(tfMap)
 
-			for k, vRaw := range tagMapRaw {
-				v, ok := vRaw.(string)
+			if i == 0 {
+				config.AssumeRoleARN = assumeRole.RoleARN
+				config.AssumeRoleDurationSeconds = assumeRole.DurationSeconds
+				config.AssumeRoleExternalID = assumeRole.ExternalID
+				config.AssumeRolePolicy = assumeRole.Policy
+				config.AssumeRolePolicyARNs = assumeRole.PolicyARNs
+				config.AssumeRoleSessionName = assumeRole.SessionName
+				config.AssumeRoleTags = assumeRole.Tags
+				config.AssumeRoleTransitiveTagKeys = assumeRole.TransitiveTagKeys
 
-				if !ok {
-					continue
-				}
+				log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
 
-				config.AssumeRoleTags[k] = v
+				continue
 			}
-		}
 
-		if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
-			for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
-				transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+			if assumeRole.RoleARN == "" {
+				return nil, fmt.Errorf("assume_role configuration block %d: role_arn is required when chaining roles", i+1)
+			}
 
-				if !ok {
-					continue
-				}
+			config.AssumeRoleChain = append(config.AssumeRoleChain, assumeRole)
 
-				config.AssumeRoleTransitiveTagKeys = append(config.AssumeRoleTransitiveTagKeys, transitiveTagKey)
-			}
+			log.Printf("[INFO] chained assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
 		}
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID)
+		if len(config.AssumeRoleChain) > 0 && config.AssumeRoleARN == "" {
+			return nil, fmt.Errorf("assume_role configuration block 1: role_arn is required when chaining roles")
+		}
 	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
@@ -1470,9 +1762,9 @@ var awsMutexKV = mutexkv.NewMutexKV()
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Roles to assume in order, each with the credentials of the role before it.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration_seconds": {
@@ -1528,6 +1820,70 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func expandProviderAssumeRole(m map[string]interface{}) *AssumeRole {
+	assumeRole := &AssumeRole{}
+
+	if v, ok := m["duration_seconds"].(int); ok && v != 0 {
+		assumeRole.DurationSeconds = v
+	}
+
+	if v, ok := m["external_id"].(string); ok && v != "" {
+		assumeRole.ExternalID = v
+	}
+
+	if v, ok := m["policy"].(string); ok && v != "" {
+		assumeRole.Policy = v
+	}
+
+	if policyARNSet, ok := m["policy_arns"].(*schema.Set); ok && policyARNSet.Len() > 0 {
+		for _, policyARNRaw := range policyARNSet.List() {
+			policyARN, ok := policyARNRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.PolicyARNs = append(assumeRole.PolicyARNs, policyARN)
+		}
+	}
+
+	if v, ok := m["role_arn"].(string); ok && v != "" {
+		assumeRole.RoleARN = v
+	}
+
+	if v, ok := m["session_name"].(string); ok && v != "" {
+		assumeRole.SessionName = v
+	}
+
+	if tagMapRaw, ok := m["tags"].(map[string]interface{}); ok && len(tagMapRaw) > 0 {
+		assumeRole.Tags = make(map[string]string)
+
+		for k, vRaw := range tagMapRaw {
+			v, ok := vRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.Tags[k] = v
+		}
+	}
+
+	if transitiveTagKeySet, ok := m["transitive_tag_keys"].(*schema.Set); ok && transitiveTagKeySet.Len() > 0 {
+		for _, transitiveTagKeyRaw := range transitiveTagKeySet.List() {
+			transitiveTagKey, ok := transitiveTagKeyRaw.(string)
+
+			if !ok {
+				continue
+			}
+
+			assumeRole.TransitiveTagKeys = append(assumeRole.TransitiveTagKeys, transitiveTagKey)
+		}
+	}
+
+	return assumeRole
+}
+
 func endpointsSchema() *schema.Schema {
 	endpointsAttributes := make(map[string]*schema.Schema)
 
@@ -1582,6 +1938,217 @@ func expandProviderIgnoreTags(l []interface{}) *keyvaluetags.IgnoreConfig {
 	return ignoreConfig
 }
 
+func expandProviderTagPolicy(l []interface{}) *keyvaluetags.PolicyConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	policyConfig := &keyvaluetags.PolicyConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["enforcement"].(string); ok {
+		policyConfig.Enforcement = v
+	}
+
+	if v, ok := m["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.Rules = append(policyConfig.Rules, keyvaluetags.RequiredKeysRule(aws.StringValueSlice(expandStringSet(v))))
+	}
+
+	if v, ok := m["allowed_values"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			policyConfig.Rules = append(policyConfig.Rules, keyvaluetags.AllowedValuesRule{
+				Key:    tfMap["key"].(string),
+				Values: aws.StringValueSlice(expandStringSet(tfMap["values"].(*schema.Set))),
+			})
+		}
+	}
+
+	if v, ok := m["key_case"].(string); ok && v != "" {
+		policyConfig.Rules = append(policyConfig.Rules, keyvaluetags.KeyCaseRule(v))
+	}
+
+	if v, ok := m["max_key_length"].(int); ok && v > 0 {
+		policyConfig.Rules = append(policyConfig.Rules, keyvaluetags.MaxKeyLengthRule(v))
+	}
+
+	if v, ok := m["max_value_length"].(int); ok && v > 0 {
+		policyConfig.Rules = append(policyConfig.Rules, keyvaluetags.MaxValueLengthRule(v))
+	}
+
+	return policyConfig
+}
+
+func expandProviderRateLimits(l []interface{}) map[string]ratelimit.Limit {
+	if len(l) == 0 {
+		return nil
+	}
+
+	limits := make(map[string]ratelimit.Limit)
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		limits[tfMap["service"].(string)] = ratelimit.Limit{
+			Burst:             tfMap["burst"].(int),
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+		}
+	}
+
+	return limits
+}
+
+func expandProviderRetry(l []interface{}) *retrypolicy.Config {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	retryConfig := &retrypolicy.Config{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["jitter_mode"].(string); ok && v != "" {
+		retryConfig.JitterMode = v
+	}
+
+	if v, ok := m["max_attempts"].(int); ok && v != 0 {
+		retryConfig.MaxAttempts = v
+	}
+
+	if v, ok := m["max_backoff"].(string); ok && v != "" {
+		// Validated by the schema.
+		retryConfig.MaxBackoff, _ = time.ParseDuration(v)
+	}
+
+	if v, ok := m["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+		retryConfig.RetryableErrorCodes = make(map[string][]string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			service := tfMap["service"].(string)
+
+			for _, errorCodeRaw := range tfMap["error_codes"].(*schema.Set).List() {
+				retryConfig.RetryableErrorCodes[service] = append(retryConfig.RetryableErrorCodes[service], errorCodeRaw.(string))
+			}
+		}
+	}
+
+	return retryConfig
+}
+
+func expandProviderRegionPolicy(d *schema.ResourceData) *regionpolicy.Config {
+	policyConfig := &regionpolicy.Config{}
+
+	if v, ok := d.GetOk("allowed_regions"); ok {
+		for _, regionRaw := range v.(*schema.Set).List() {
+			policyConfig.AllowedRegions = append(policyConfig.AllowedRegions, regionRaw.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("forbidden_regions"); ok {
+		for _, regionRaw := range v.(*schema.Set).List() {
+			policyConfig.ForbiddenRegions = append(policyConfig.ForbiddenRegions, regionRaw.(string))
+		}
+	}
+
+	if v, ok := d.GetOk("allowed_partitions"); ok {
+		for _, partitionRaw := range v.(*schema.Set).List() {
+			policyConfig.AllowedPartitions = append(policyConfig.AllowedPartitions, partitionRaw.(string))
+		}
+	}
+
+	return policyConfig
+}
+
+func expandProviderResourcePolicy(l []interface{}) *resourcepolicy.Config {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	policyConfig := &resourcepolicy.Config{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["allowed_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		for _, patternRaw := range v.List() {
+			policyConfig.AllowedResourceTypes = append(policyConfig.AllowedResourceTypes, patternRaw.(string))
+		}
+	}
+
+	if v, ok := m["denied_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		for _, patternRaw := range v.List() {
+			policyConfig.DeniedResourceTypes = append(policyConfig.DeniedResourceTypes, patternRaw.(string))
+		}
+	}
+
+	return policyConfig
+}
+
+// concurrencyLimitedServices are the services whose resources respect the
+// provider max_concurrent_operations.
+var concurrencyLimitedServices = []string{
+	"eks",
+	"lambda",
+}
+
+func expandProviderMaxConcurrentOperations(m map[string]interface{}) map[string]int {
+	if len(m) == 0 {
+		return nil
+	}
+
+	limits := make(map[string]int, len(m))
+
+	for service, v := range m {
+		limits[service] = v.(int)
+	}
+
+	return limits
+}
+
+func validateProviderMaxConcurrentOperations(v interface{}, k string) (ws []string, errors []error) {
+	for service, limitRaw := range v.(map[string]interface{}) {
+		supported := false
+
+		for _, v := range concurrencyLimitedServices {
+			if service == v {
+				supported = true
+				break
+			}
+		}
+
+		if !supported {
+			errors = append(errors, fmt.Errorf("%q: service %q does not support concurrency limits, expected one of: %s", k, service, strings.Join(concurrencyLimitedServices, ", ")))
+			continue
+		}
+
+		if limit, ok := limitRaw.(int); ok && limit < 1 {
+			errors = append(errors, fmt.Errorf("%q: concurrent %s operations must be at least 1, got: %d", k, service, limit))
+		}
+	}
+
+	return
+}
+
+func validateResourcePolicyPattern(v interface{}, k string) (ws []string, errors []error) {
+	if err := resourcepolicy.ValidatePattern(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
 // ReverseDns switches a DNS hostname to reverse DNS and vice-versa.
 func ReverseDns(hostname string) string {
 	parts := strings.Split(hostname, ".")
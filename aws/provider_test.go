@@ -180,6 +180,51 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+func TestProvider_DefaultTagsResources(t *testing.T) {
+	// These resources manage tags through their service's own create and update
+	// APIs rather than the generated keyvaluetags functions and do not yet
+	// support the provider default_tags configuration.
+	excludedResources := map[string]bool{
+		"aws_cloudformation_stack":                                 true,
+		"aws_cloudformation_stack_set":                             true,
+		"aws_codebuild_project":                                    true,
+		"aws_codebuild_report_group":                               true,
+		"aws_cognito_user_pool":                                    true,
+		"aws_s3_object_copy":                                       true,
+		"aws_secretsmanager_secret_rotation":                       true,
+		"aws_serverlessapplicationrepository_cloudformation_stack": true,
+		"aws_servicecatalog_portfolio":                             true,
+		"aws_ssm_activation":                                       true,
+	}
+
+	for name, r := range Provider().ResourcesMap {
+		if excludedResources[name] {
+			continue
+		}
+
+		tagsSchema, ok := r.Schema["tags"]
+
+		if !ok || tagsSchema.Type != schema.TypeMap || !tagsSchema.Optional {
+			continue
+		}
+
+		tagsAllSchema, ok := r.Schema["tags_all"]
+
+		if !ok {
+			t.Errorf("resource %s: missing tags_all attribute", name)
+			continue
+		}
+
+		if !tagsAllSchema.Computed {
+			t.Errorf("resource %s: tags_all attribute must be Computed", name)
+		}
+
+		if r.CustomizeDiff == nil {
+			t.Errorf("resource %s: missing CustomizeDiff with SetTagsDiff", name)
+		}
+	}
+}
+
 func TestReverseDns(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -223,6 +268,43 @@ func TestReverseDns(t *testing.T) {
 	}
 }
 
+func TestValidateProviderMaxConcurrentOperations(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       map[string]interface{}
+		expectError bool
+	}{
+		{
+			name:  "empty",
+			input: map[string]interface{}{},
+		},
+		{
+			name:  "supported services",
+			input: map[string]interface{}{"eks": 2, "lambda": 1},
+		},
+		{
+			name:        "unsupported service",
+			input:       map[string]interface{}{"s3": 2},
+			expectError: true,
+		},
+		{
+			name:        "zero limit",
+			input:       map[string]interface{}{"eks": 0},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, errors := validateProviderMaxConcurrentOperations(testCase.input, "max_concurrent_operations")
+
+			if got, want := len(errors) > 0, testCase.expectError; got != want {
+				t.Errorf("got errors: %v, expected error: %t", errors, want)
+			}
+		})
+	}
+}
+
 // testAccPreCheck verifies and sets required provider testing configuration
 //
 // This PreCheck function should be present in every acceptance test. It allows
@@ -770,7 +852,7 @@ func testAccPartitionPreCheck(partition string, t *testing.T) {
 }
 
 func testAccOrganizationsAccountPreCheck(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 	input := &organizations.DescribeOrganizationInput{}
 	_, err := conn.DescribeOrganization(input)
 	if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
@@ -783,7 +865,7 @@ func testAccOrganizationsAccountPreCheck(t *testing.T) {
 }
 
 func testAccOrganizationsEnabledPreCheck(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).organizationsconn
+	conn := testAccProvider.Meta().(*AWSClient).organizationsconn()
 	input := &organizations.DescribeOrganizationInput{}
 	_, err := conn.DescribeOrganization(input)
 	if isAWSErr(err, organizations.ErrCodeAWSOrganizationsNotInUseException, "") {
@@ -795,7 +877,7 @@ func testAccOrganizationsEnabledPreCheck(t *testing.T) {
 }
 
 func testAccPreCheckIamServiceLinkedRole(t *testing.T, pathPrefix string) {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	input := &iam.ListRolesInput{
 		PathPrefix: aws.String(pathPrefix),
@@ -1611,6 +1693,68 @@ func TestAccAWSProvider_AssumeRole_Empty(t *testing.T) {
 	})
 }
 
+func TestAccAWSProvider_AssumeRole_ChainMissingRoleArn(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ErrorCheck:        testAccErrorCheck(t),
+		ProviderFactories: testAccProviderFactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckAWSProviderConfigAssumeRoleChainMissingRoleArn,
+				ExpectError: regexp.MustCompile(`assume_role configuration block 2: role_arn is required when chaining roles`),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_TagPolicy_RequiredKeys(t *testing.T) {
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSProviderConfigTagPolicyRequiredKeys(""),
+				ExpectError: regexp.MustCompile(`aws_vpc does not conform to the provider tag_policy: tag "Owner": required tag is missing`),
+			},
+			{
+				Config: testAccAWSProviderConfigTagPolicyRequiredKeys("team"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Owner", "team"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSProvider_TagPolicy_WarnEnforcement(t *testing.T) {
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSProviderConfigTagPolicyWarnEnforcement(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "test"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSProviderDnsSuffix(providers *[]*schema.Provider, expectedDnsSuffix string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if providers == nil {
@@ -1953,7 +2097,7 @@ func testAccPreCheckEc2ClassicOrHasDefaultVpcWithDefaultSubnets(t *testing.T) {
 
 // testAccHasDefaultVpc returns whether the current AWS region has a default VPC.
 func testAccHasDefaultVpc(t *testing.T) bool {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	resp, err := conn.DescribeAccountAttributes(&ec2.DescribeAccountAttributesInput{
 		AttributeNames: aws.StringSlice([]string{ec2.AccountAttributeNameDefaultVpc}),
@@ -1973,7 +2117,7 @@ func testAccHasDefaultVpc(t *testing.T) bool {
 
 // testAccDefaultSubnetCount returns the number of default subnets in the current region's default VPC.
 func testAccDefaultSubnetCount(t *testing.T) int {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	input := &ec2.DescribeSubnetsInput{
 		Filters: buildEC2AttributeFilterList(map[string]string{
@@ -2062,6 +2206,58 @@ provider "aws" {
 `)
 }
 
+func testAccAWSProviderConfigTagPolicyRequiredKeys(defaultOwner string) string {
+	defaultTags := ""
+	if defaultOwner != "" {
+		defaultTags = fmt.Sprintf(`
+  default_tags {
+    tags = {
+      Owner = %[1]q
+    }
+  }
+`, defaultOwner)
+	}
+
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  tag_policy {
+    required_keys = ["Owner"]
+  }
+%[1]s
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+}
+`, defaultTags)
+}
+
+func testAccAWSProviderConfigTagPolicyWarnEnforcement() string {
+	//lintignore:AT004
+	return `
+provider "aws" {
+  tag_policy {
+    enforcement = "warn"
+    key_case    = "PascalCase"
+
+    allowed_values {
+      key    = "environment"
+      values = ["dev", "prod"]
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    environment = "test"
+  }
+}
+`
+}
+
 func testAccAWSProviderConfigDefaultAndIgnoreTagsEmptyConfigurationBlock() string {
 	//lintignore:AT004
 	return composeConfig(
@@ -2253,6 +2449,20 @@ provider "aws" {
 data "aws_caller_identity" "current" {}
 ` //lintignore:AT004
 
+const testAccCheckAWSProviderConfigAssumeRoleChainMissingRoleArn = `
+provider "aws" {
+  assume_role {
+    role_arn = "arn:aws:iam::123456789012:role/hub"
+  }
+
+  assume_role {
+    session_name = "target"
+  }
+}
+
+data "aws_caller_identity" "current" {}
+` //lintignore:AT004
+
 const testAccProviderConfigBase = `
 data "aws_partition" "provider_test" {}
 
@@ -149,7 +149,7 @@ func testAccAWSAccessAnalyzerAnalyzer_Type_Organization(t *testing.T) {
 }
 
 func testAccCheckAccessAnalyzerAnalyzerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).accessanalyzerconn
+	conn := testAccProvider.Meta().(*AWSClient).accessanalyzerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_accessanalyzer_analyzer" {
@@ -181,7 +181,7 @@ func testAccCheckAccessAnalyzerAnalyzerDestroy(s *terraform.State) error {
 
 func testAccCheckAwsAccessAnalyzerAnalyzerDisappears(analyzer *accessanalyzer.AnalyzerSummary) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).accessanalyzerconn
+		conn := testAccProvider.Meta().(*AWSClient).accessanalyzerconn()
 
 		input := &accessanalyzer.DeleteAnalyzerInput{
 			AnalyzerName: analyzer.Name,
@@ -204,7 +204,7 @@ func testAccCheckAwsAccessAnalyzerAnalyzerExists(resourceName string, analyzer *
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).accessanalyzerconn
+		conn := testAccProvider.Meta().(*AWSClient).accessanalyzerconn()
 
 		input := &accessanalyzer.GetAnalyzerInput{
 			AnalyzerName: aws.String(rs.Primary.ID),
@@ -104,7 +104,7 @@ func resourceAwsAcmpcaCertificate() *schema.Resource {
 }
 
 func resourceAwsAcmpcaCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 
 	certificateAuthorityArn := d.Get("certificate_authority_arn").(string)
 	input := &acmpca.IssueCertificateInput{
@@ -159,7 +159,7 @@ func resourceAwsAcmpcaCertificateCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAcmpcaCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 
 	getCertificateInput := &acmpca.GetCertificateInput{
 		CertificateArn:          aws.String(d.Id()),
@@ -186,7 +186,7 @@ func resourceAwsAcmpcaCertificateRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsAcmpcaCertificateRevoke(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).acmpcaconn
+	conn := meta.(*AWSClient).acmpcaconn()
 
 	block, _ := pem.Decode([]byte(d.Get("certificate").(string)))
 	if block == nil {
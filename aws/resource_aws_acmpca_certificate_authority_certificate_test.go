@@ -111,7 +111,7 @@ func testAccCheckAwsAcmpcaCertificateAuthorityCertificateExists(resourceName str
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 
 		output, err := finder.CertificateAuthorityCertificateByARN(conn, rs.Primary.ID)
 		if err != nil {
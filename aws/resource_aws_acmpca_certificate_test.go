@@ -221,7 +221,7 @@ func TestAccAwsAcmpcaCertificate_Validity_Absolute(t *testing.T) {
 }
 
 func testAccCheckAwsAcmpcaCertificateDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+	conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_acmpca_certificate" {
@@ -260,7 +260,7 @@ func testAccCheckAwsAcmpcaCertificateExists(resourceName string) resource.TestCh
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn()
 		input := &acmpca.GetCertificateInput{
 			CertificateArn:          aws.String(rs.Primary.ID),
 			CertificateAuthorityArn: aws.String(rs.Primary.Attributes["certificate_authority_arn"]),
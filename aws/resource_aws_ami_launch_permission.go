@@ -47,7 +47,7 @@ func resourceAwsAmiLaunchPermission() *schema.Resource {
 }
 
 func resourceAwsAmiLaunchPermissionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	image_id := d.Get("image_id").(string)
 	account_id := d.Get("account_id").(string)
@@ -70,7 +70,7 @@ func resourceAwsAmiLaunchPermissionCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsAmiLaunchPermissionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	exists, err := hasLaunchPermission(conn, d.Get("image_id").(string), d.Get("account_id").(string))
 	if err != nil {
@@ -86,7 +86,7 @@ func resourceAwsAmiLaunchPermissionRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsAmiLaunchPermissionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	image_id := d.Get("image_id").(string)
 	account_id := d.Get("account_id").(string)
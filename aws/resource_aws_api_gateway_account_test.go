@@ -93,7 +93,7 @@ func testAccCheckAWSAPIGatewayAccountExists(n string, res *apigateway.Account) r
 			return fmt.Errorf("No API Gateway Account ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).apigatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).apigatewayconn()
 
 		req := &apigateway.GetAccountInput{}
 		describe, err := conn.GetAccount(req)
@@ -118,7 +118,7 @@ func testAccCheckAWSAPIGatewayAccountDestroy(s *terraform.State) error {
 
 // testAccPreCheckAWSAPIGatewayAccountCloudWatchRoleArn checks whether a CloudWatch role ARN has been configured in the current AWS region.
 func testAccPreCheckAWSAPIGatewayAccountCloudWatchRoleArn(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).apigatewayconn
+	conn := testAccProvider.Meta().(*AWSClient).apigatewayconn()
 
 	output, err := conn.GetAccount(&apigateway.GetAccountInput{})
 
@@ -49,7 +49,7 @@ func resourceAwsApiGatewayV2RouteResponse() *schema.Resource {
 }
 
 func resourceAwsApiGatewayV2RouteResponseCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	req := &apigatewayv2.CreateRouteResponseInput{
 		ApiId:            aws.String(d.Get("api_id").(string)),
@@ -75,7 +75,7 @@ func resourceAwsApiGatewayV2RouteResponseCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsApiGatewayV2RouteResponseRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	resp, err := conn.GetRouteResponse(&apigatewayv2.GetRouteResponseInput{
 		ApiId:           aws.String(d.Get("api_id").(string)),
@@ -101,7 +101,7 @@ func resourceAwsApiGatewayV2RouteResponseRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsApiGatewayV2RouteResponseUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	req := &apigatewayv2.UpdateRouteResponseInput{
 		ApiId:           aws.String(d.Get("api_id").(string)),
@@ -128,7 +128,7 @@ func resourceAwsApiGatewayV2RouteResponseUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsApiGatewayV2RouteResponseDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).apigatewayv2conn
+	conn := meta.(*AWSClient).apigatewayv2conn()
 
 	log.Printf("[DEBUG] Deleting API Gateway v2 route response (%s)", d.Id())
 	_, err := conn.DeleteRouteResponse(&apigatewayv2.DeleteRouteResponseInput{
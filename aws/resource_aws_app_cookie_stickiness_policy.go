@@ -61,7 +61,7 @@ func resourceAwsAppCookieStickinessPolicy() *schema.Resource {
 }
 
 func resourceAwsAppCookieStickinessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	// Provision the AppStickinessPolicy
 	acspOpts := &elb.CreateAppCookieStickinessPolicyInput{
@@ -92,7 +92,7 @@ func resourceAwsAppCookieStickinessPolicyCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsAppCookieStickinessPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	lbName, lbPort, policyName := resourceAwsAppCookieStickinessPolicyParseId(d.Id())
 
@@ -184,7 +184,7 @@ func resourceAwsELBSticknessPolicyAssigned(policyName, lbName, lbPort string, el
 }
 
 func resourceAwsAppCookieStickinessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	lbName, _, policyName := resourceAwsAppCookieStickinessPolicyParseId(d.Id())
 
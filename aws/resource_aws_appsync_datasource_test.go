@@ -397,7 +397,7 @@ func TestAccAwsAppsyncDatasource_Type_None(t *testing.T) {
 }
 
 func testAccCheckAwsAppsyncDatasourceDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_datasource" {
 			continue
@@ -441,7 +441,7 @@ func testAccCheckAwsAppsyncDatasourceExists(name string) resource.TestCheckFunc
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 
 		input := &appsync.GetDataSourceInput{
 			ApiId: aws.String(apiID),
@@ -256,7 +256,7 @@ func TestAccAwsAppsyncResolver_CachingConfig(t *testing.T) {
 }
 
 func testAccCheckAwsAppsyncResolverDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+	conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_appsync_resolver" {
 			continue
@@ -303,7 +303,7 @@ func testAccCheckAwsAppsyncResolverExists(name string, resolver *appsync.Resolve
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).appsyncconn
+		conn := testAccProvider.Meta().(*AWSClient).appsyncconn()
 
 		input := &appsync.GetResolverInput{
 			ApiId:     aws.String(apiID),
@@ -134,8 +134,8 @@ func TestAccAWSAthenaDatabase_forceDestroyAlwaysSucceeds(t *testing.T) {
 // StartQueryExecution requires OutputLocation but terraform destroy deleted S3 bucket as well.
 // So temporary S3 bucket as OutputLocation is created to confirm whether the database is actually deleted.
 func testAccCheckAWSAthenaDatabaseDestroy(s *terraform.State) error {
-	athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn
-	s3conn := testAccProvider.Meta().(*AWSClient).s3conn
+	athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn()
+	s3conn := testAccProvider.Meta().(*AWSClient).s3conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_athena_database" {
 			continue
@@ -236,7 +236,7 @@ func testAccAWSAthenaDatabaseCreateTables(dbName string) resource.TestCheckFunc
 			return err
 		}
 
-		athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn
+		athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn()
 
 		input := &athena.StartQueryExecutionInput{
 			QueryExecutionContext: &athena.QueryExecutionContext{
@@ -266,7 +266,7 @@ func testAccAWSAthenaDatabaseDestroyTables(dbName string) resource.TestCheckFunc
 			return err
 		}
 
-		athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn
+		athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn()
 
 		input := &athena.StartQueryExecutionInput{
 			QueryExecutionContext: &athena.QueryExecutionContext{
@@ -295,7 +295,7 @@ func testAccCheckAWSAthenaDatabaseDropFails(dbName string) resource.TestCheckFun
 			return err
 		}
 
-		athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn
+		athenaconn := testAccProvider.Meta().(*AWSClient).athenaconn()
 
 		input := &athena.StartQueryExecutionInput{
 			QueryExecutionContext: &athena.QueryExecutionContext{
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfautoscaling "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/autoscaling"
 )
 
 func resourceAwsAutoscalingAttachment() *schema.Resource {
@@ -16,6 +17,10 @@ func resourceAwsAutoscalingAttachment() *schema.Resource {
 		Read:   resourceAwsAutoscalingAttachmentRead,
 		Delete: resourceAwsAutoscalingAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"autoscaling_group_name": {
 				Type:     schema.TypeString,
@@ -39,7 +44,7 @@ func resourceAwsAutoscalingAttachment() *schema.Resource {
 }
 
 func resourceAwsAutoscalingAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	asgconn := meta.(*AWSClient).autoscalingconn
+	asgconn := meta.(*AWSClient).autoscalingconn()
 	asgName := d.Get("autoscaling_group_name").(string)
 
 	if v, ok := d.GetOk("elb"); ok {
@@ -75,7 +80,7 @@ func resourceAwsAutoscalingAttachmentCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsAutoscalingAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	asgconn := meta.(*AWSClient).autoscalingconn
+	asgconn := meta.(*AWSClient).autoscalingconn()
 	asgName := d.Get("autoscaling_group_name").(string)
 
 	// Retrieve the ASG properties to get list of associated ELBs
@@ -125,8 +130,27 @@ func resourceAwsAutoscalingAttachmentRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func resourceAwsAutoscalingAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	asgName, elbName, targetGroupArn, err := tfautoscaling.AttachmentParseImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	//lintignore:R016 // Allow legacy unstable ID usage in managed resource
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", asgName)))
+	d.Set("autoscaling_group_name", asgName)
+	if elbName != "" {
+		d.Set("elb", elbName)
+	}
+	if targetGroupArn != "" {
+		d.Set("alb_target_group_arn", targetGroupArn)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAutoscalingAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	asgconn := meta.(*AWSClient).autoscalingconn
+	asgconn := meta.(*AWSClient).autoscalingconn()
 	asgName := d.Get("autoscaling_group_name").(string)
 
 	if v, ok := d.GetOk("elb"); ok {
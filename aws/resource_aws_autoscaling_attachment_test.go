@@ -33,6 +33,12 @@ func TestAccAWSAutoscalingAttachment_elb(t *testing.T) {
 					testAccCheckAWSAutocalingElbAttachmentExists("aws_autoscaling_group.asg", 1),
 				),
 			},
+			{
+				// The attachment ID is generated, so it differs after import.
+				ResourceName:      "aws_autoscaling_attachment.asg_attachment_foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAutoscalingAttachmentImportStateIdFunc("aws_autoscaling_attachment.asg_attachment_foo", "elb"),
+			},
 			{
 				Config: testAccAWSAutoscalingAttachment_elb_double_associated(rInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -77,6 +83,12 @@ func TestAccAWSAutoscalingAttachment_albTargetGroup(t *testing.T) {
 					testAccCheckAWSAutocalingAlbAttachmentExists("aws_autoscaling_group.asg", 1),
 				),
 			},
+			{
+				// The attachment ID is generated, so it differs after import.
+				ResourceName:      "aws_autoscaling_attachment.asg_attachment_foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAutoscalingAttachmentImportStateIdFunc("aws_autoscaling_attachment.asg_attachment_foo", "alb_target_group_arn"),
+			},
 			{
 				Config: testAccAWSAutoscalingAttachment_alb_double_associated(rInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -100,7 +112,7 @@ func TestAccAWSAutoscalingAttachment_albTargetGroup(t *testing.T) {
 }
 
 func testAccCheckAWSAutocalingAttachmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+	conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscaling_attachment" {
@@ -132,7 +144,7 @@ func testAccCheckAWSAutocalingElbAttachmentExists(asgname string, loadBalancerCo
 			return fmt.Errorf("Not found: %s", asgname)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 		asg := rs.Primary.ID
 
 		actual, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
@@ -158,7 +170,7 @@ func testAccCheckAWSAutocalingAlbAttachmentExists(asgname string, targetGroupCou
 			return fmt.Errorf("Not found: %s", asgname)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingconn()
 		asg := rs.Primary.ID
 
 		actual, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
@@ -376,3 +388,14 @@ resource "aws_autoscaling_attachment" "asg_attachment_bar" {
   alb_target_group_arn   = aws_lb_target_group.another_test.arn
 }`
 }
+
+func testAccAWSAutoscalingAttachmentImportStateIdFunc(resourceName, targetAttribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["autoscaling_group_name"], rs.Primary.Attributes[targetAttribute]), nil
+	}
+}
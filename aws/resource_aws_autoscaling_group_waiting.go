@@ -36,7 +36,7 @@ func waitForASGCapacity(
 	log.Printf("[DEBUG] Waiting on %s for capacity...", d.Id())
 
 	err = resource.Retry(wait, func() *resource.RetryError {
-		g, err := getAwsAutoscalingGroup(d.Id(), meta.(*AWSClient).autoscalingconn)
+		g, err := getAwsAutoscalingGroup(d.Id(), meta.(*AWSClient).autoscalingconn())
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -54,7 +54,7 @@ func waitForASGCapacity(
 		return resource.RetryableError(fmt.Errorf("%q: Waiting up to %s: %s", d.Id(), wait, reason))
 	})
 	if isResourceTimeoutError(err) {
-		g, err := getAwsAutoscalingGroup(d.Id(), meta.(*AWSClient).autoscalingconn)
+		g, err := getAwsAutoscalingGroup(d.Id(), meta.(*AWSClient).autoscalingconn())
 
 		if err != nil {
 			return fmt.Errorf("Error getting Auto Scaling Group info: %s", err)
@@ -78,7 +78,7 @@ func waitForASGCapacity(
 
 	recentStatus := ""
 
-	conn := meta.(*AWSClient).autoscalingconn
+	conn := meta.(*AWSClient).autoscalingconn()
 	resp, aErr := conn.DescribeScalingActivities(&autoscaling.DescribeScalingActivitiesInput{
 		AutoScalingGroupName: aws.String(d.Id()),
 		MaxRecords:           aws.Int64(1),
@@ -317,7 +317,7 @@ func resourceAwsAutoScalingPlansScalingPlan() *schema.Resource {
 }
 
 func resourceAwsAutoScalingPlansScalingPlanCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingplansconn
+	conn := meta.(*AWSClient).autoscalingplansconn()
 
 	scalingPlanName := d.Get("name").(string)
 
@@ -346,7 +346,7 @@ func resourceAwsAutoScalingPlansScalingPlanCreate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsAutoScalingPlansScalingPlanRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingplansconn
+	conn := meta.(*AWSClient).autoscalingplansconn()
 
 	scalingPlan, err := finder.ScalingPlan(conn, d.Get("name").(string), d.Get("scaling_plan_version").(int))
 	if err != nil {
@@ -373,7 +373,7 @@ func resourceAwsAutoScalingPlansScalingPlanRead(d *schema.ResourceData, meta int
 }
 
 func resourceAwsAutoScalingPlansScalingPlanUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingplansconn
+	conn := meta.(*AWSClient).autoscalingplansconn()
 
 	scalingPlanName := d.Get("name").(string)
 	scalingPlanVersion := d.Get("scaling_plan_version").(int)
@@ -400,7 +400,7 @@ func resourceAwsAutoScalingPlansScalingPlanUpdate(d *schema.ResourceData, meta i
 }
 
 func resourceAwsAutoScalingPlansScalingPlanDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).autoscalingplansconn
+	conn := meta.(*AWSClient).autoscalingplansconn()
 
 	scalingPlanName := d.Get("name").(string)
 	scalingPlanVersion := d.Get("scaling_plan_version").(int)
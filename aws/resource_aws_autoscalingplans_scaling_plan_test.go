@@ -29,7 +29,7 @@ func testSweepAutoScalingPlansScalingPlans(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).autoscalingplansconn
+	conn := client.(*AWSClient).autoscalingplansconn()
 	input := &autoscalingplans.DescribeScalingPlansInput{}
 	var sweeperErrs *multierror.Error
 
@@ -281,7 +281,7 @@ func TestAccAwsAutoScalingPlansScalingPlan_disappears(t *testing.T) {
 }
 
 func testAccCheckAutoScalingPlansScalingPlanDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).autoscalingplansconn
+	conn := testAccProvider.Meta().(*AWSClient).autoscalingplansconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_autoscalingplans_scaling_plan" {
@@ -308,7 +308,7 @@ func testAccCheckAutoScalingPlansScalingPlanDestroy(s *terraform.State) error {
 
 func testAccCheckAutoScalingPlansScalingPlanExists(name string, v *autoscalingplans.ScalingPlan) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).autoscalingplansconn
+		conn := testAccProvider.Meta().(*AWSClient).autoscalingplansconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -29,7 +29,7 @@ func resourceAwsBackupGlobalSettings() *schema.Resource {
 }
 
 func resourceAwsBackupGlobalSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	input := &backup.UpdateGlobalSettingsInput{
 		GlobalSettings: stringMapToPointers(d.Get("global_settings").(map[string]interface{})),
@@ -46,7 +46,7 @@ func resourceAwsBackupGlobalSettingsUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsBackupGlobalSettingsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).backupconn
+	conn := meta.(*AWSClient).backupconn()
 
 	resp, err := conn.DescribeGlobalSettings(&backup.DescribeGlobalSettingsInput{})
 	if err != nil {
@@ -34,8 +34,8 @@ func testSweepBatchComputeEnvironments(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).batchconn
-	iamconn := client.(*AWSClient).iamconn
+	conn := client.(*AWSClient).batchconn()
+	iamconn := client.(*AWSClient).iamconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -681,7 +681,7 @@ func TestAccAWSBatchComputeEnvironment_Tags(t *testing.T) {
 }
 
 func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_batch_compute_environment" {
@@ -708,7 +708,7 @@ func testAccCheckBatchComputeEnvironmentDestroy(s *terraform.State) error {
 
 func testAccCheckAwsBatchComputeEnvironmentExists() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).batchconn
+		conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_batch_compute_environment" {
@@ -736,7 +736,7 @@ func testAccCheckAwsBatchComputeEnvironmentExists() resource.TestCheckFunc {
 }
 
 func testAccPreCheckAWSBatch(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).batchconn
+	conn := testAccProvider.Meta().(*AWSClient).batchconn()
 
 	input := &batch.DescribeComputeEnvironmentsInput{}
 
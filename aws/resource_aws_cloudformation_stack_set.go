@@ -99,7 +99,7 @@ func resourceAwsCloudFormationStackSet() *schema.Resource {
 }
 
 func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 	name := d.Get("name").(string)
 
 	input := &cloudformation.CreateStackSetInput{
@@ -146,7 +146,7 @@ func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	input := &cloudformation.DescribeStackSetInput{
@@ -199,7 +199,7 @@ func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	input := &cloudformation.UpdateStackSetInput{
 		AdministrationRoleARN: aws.String(d.Get("administration_role_arn").(string)),
@@ -248,7 +248,7 @@ func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsCloudFormationStackSetDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	input := &cloudformation.DeleteStackSetInput{
 		StackSetName: aws.String(d.Id()),
@@ -31,7 +31,7 @@ func testSweepCloudformationStackSets(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).cfconn
+	conn := client.(*AWSClient).cfconn()
 	stackSets, err := listCloudFormationStackSets(conn)
 
 	if testSweepSkipSweepError(err) || isAWSErr(err, "ValidationError", "AWS CloudFormation StackSets is not supported") {
@@ -598,7 +598,7 @@ func testAccCheckCloudFormationStackSetExists(resourceName string, stackSet *clo
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 		input := &cloudformation.DescribeStackSetInput{
 			StackSetName: aws.String(rs.Primary.ID),
@@ -621,7 +621,7 @@ func testAccCheckCloudFormationStackSetExists(resourceName string, stackSet *clo
 }
 
 func testAccCheckAWSCloudFormationStackSetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cfconn
+	conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudformation_stack_set" {
@@ -671,7 +671,7 @@ func testAccCheckCloudFormationStackSetRecreated(i, j *cloudformation.StackSet)
 }
 
 func testAccPreCheckAWSCloudFormationStackSet(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).cfconn
+	conn := testAccProvider.Meta().(*AWSClient).cfconn()
 
 	input := &cloudformation.ListStackSetsInput{}
 	_, err := conn.ListStackSets(input)
@@ -124,7 +124,7 @@ func resourceAwsCloudFrontOriginRequestPolicy() *schema.Resource {
 }
 
 func resourceAwsCloudFrontOriginRequestPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	request := &cloudfront.CreateOriginRequestPolicyInput{
 		OriginRequestPolicyConfig: expandCloudFrontOriginRequestPolicyConfig(d),
@@ -142,7 +142,7 @@ func resourceAwsCloudFrontOriginRequestPolicyCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsCloudFrontOriginRequestPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 	request := &cloudfront.GetOriginRequestPolicyInput{
 		Id: aws.String(d.Id()),
 	}
@@ -175,7 +175,7 @@ func resourceAwsCloudFrontOriginRequestPolicyRead(d *schema.ResourceData, meta i
 }
 
 func resourceAwsCloudFrontOriginRequestPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	request := &cloudfront.UpdateOriginRequestPolicyInput{
 		OriginRequestPolicyConfig: expandCloudFrontOriginRequestPolicyConfig(d),
@@ -192,7 +192,7 @@ func resourceAwsCloudFrontOriginRequestPolicyUpdate(d *schema.ResourceData, meta
 }
 
 func resourceAwsCloudFrontOriginRequestPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudfrontconn
+	conn := meta.(*AWSClient).cloudfrontconn()
 
 	request := &cloudfront.DeleteOriginRequestPolicyInput{
 		Id:      aws.String(d.Id()),
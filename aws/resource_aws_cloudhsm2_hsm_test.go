@@ -90,7 +90,7 @@ resource "aws_cloudhsm_v2_hsm" "hsm" {
 }
 
 func testAccCheckAWSCloudHsmV2HsmDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn
+	conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudhsm_v2_hsm" {
@@ -113,7 +113,7 @@ func testAccCheckAWSCloudHsmV2HsmDestroy(s *terraform.State) error {
 
 func testAccCheckAWSCloudHsmV2HsmExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn
+		conn := testAccProvider.Meta().(*AWSClient).cloudhsmv2conn()
 
 		it, ok := s.RootModule().Resources[name]
 		if !ok {
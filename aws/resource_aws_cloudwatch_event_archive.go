@@ -62,7 +62,7 @@ func resourceAwsCloudWatchEventArchive() *schema.Resource {
 }
 
 func resourceAwsCloudWatchEventArchiveCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input, err := buildCreateArchiveInputStruct(d)
 
@@ -85,7 +85,7 @@ func resourceAwsCloudWatchEventArchiveCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsCloudWatchEventArchiveRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 	input := &events.DescribeArchiveInput{
 		ArchiveName: aws.String(d.Id()),
 	}
@@ -115,7 +115,7 @@ func resourceAwsCloudWatchEventArchiveRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsCloudWatchEventArchiveUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input, err := buildUpdateArchiveInputStruct(d)
 
@@ -133,7 +133,7 @@ func resourceAwsCloudWatchEventArchiveUpdate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsCloudWatchEventArchiveDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cloudwatcheventsconn
+	conn := meta.(*AWSClient).cloudwatcheventsconn()
 
 	input := &events.DeleteArchiveInput{
 		ArchiveName: aws.String(d.Get("name").(string)),
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
 	"regexp"
 	"testing"
 
@@ -12,12 +11,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents/lister"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
-		Name: "aws_cloudwatch_event_bus",
-		F:    testSweepCloudWatchEventBuses,
+	addSweeper(&sweep.Sweeper{
+		Name:    "aws_cloudwatch_event_bus",
+		Service: events.ServiceName,
+		List:    testSweepCloudWatchEventBuses,
 		Dependencies: []string{
 			"aws_cloudwatch_event_rule",
 			"aws_cloudwatch_event_target",
@@ -25,52 +27,33 @@ func init() {
 	})
 }
 
-func testSweepCloudWatchEventBuses(region string) error {
+func testSweepCloudWatchEventBuses(region string) ([]*sweep.Resource, error) {
 	client, err := sharedClientForRegion(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %w", err)
+		return nil, fmt.Errorf("Error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).cloudwatcheventsconn
+	conn := client.(*AWSClient).cloudwatcheventsconn()
 
 	input := &events.ListEventBusesInput{}
+	var resources []*sweep.Resource
 
-	for {
-		output, err := conn.ListEventBuses(input)
-		if err != nil {
-			if testSweepSkipSweepError(err) {
-				log.Printf("[WARN] Skipping CloudWatch Events event bus sweep for %s: %s", region, err)
-				return nil
-			}
-			return fmt.Errorf("Error retrieving CloudWatch Events event bus: %w", err)
-		}
-
-		if len(output.EventBuses) == 0 {
-			log.Print("[DEBUG] No CloudWatch Events event buses to sweep")
-			return nil
-		}
-
-		for _, eventBus := range output.EventBuses {
+	err = lister.ListEventBusesPages(conn, input, func(page *events.ListEventBusesOutput, lastPage bool) bool {
+		for _, eventBus := range page.EventBuses {
 			name := aws.StringValue(eventBus.Name)
 			if name == "default" {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting CloudWatch Events event bus (%s)", name)
-			_, err := conn.DeleteEventBus(&events.DeleteEventBusInput{
-				Name: aws.String(name),
+			resources = append(resources, &sweep.Resource{
+				ID:     name,
+				Delete: sweepSchemaResource(resourceAwsCloudWatchEventBus(), name, client),
 			})
-			if err != nil {
-				return fmt.Errorf("Error deleting CloudWatch Events event bus (%s): %w", name, err)
-			}
 		}
 
-		if output.NextToken == nil {
-			break
-		}
-		input.NextToken = output.NextToken
-	}
+		return !lastPage
+	})
 
-	return nil
+	return resources, err
 }
 
 func TestAccAWSCloudWatchEventBus_basic(t *testing.T) {
@@ -219,7 +202,7 @@ func TestAccAWSCloudWatchEventBus_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSCloudWatchEventBusDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cloudwatch_event_bus" {
@@ -247,7 +230,7 @@ func testAccCheckCloudWatchEventBusExists(n string, v *events.DescribeEventBusOu
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn()
 		params := events.DescribeEventBusInput{
 			Name: aws.String(rs.Primary.ID),
 		}
@@ -60,7 +60,7 @@ func resourceAwsCodeBuildSourceCredential() *schema.Resource {
 }
 
 func resourceAwsCodeBuildSourceCredentialCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codebuildconn
+	conn := meta.(*AWSClient).codebuildconn()
 
 	authType := d.Get("auth_type").(string)
 
@@ -85,7 +85,7 @@ func resourceAwsCodeBuildSourceCredentialCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsCodeBuildSourceCredentialRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codebuildconn
+	conn := meta.(*AWSClient).codebuildconn()
 
 	resp, err := conn.ListSourceCredentials(&codebuild.ListSourceCredentialsInput{})
 	if err != nil {
@@ -115,7 +115,7 @@ func resourceAwsCodeBuildSourceCredentialRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsCodeBuildSourceCredentialDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).codebuildconn
+	conn := meta.(*AWSClient).codebuildconn()
 
 	deleteOpts := &codebuild.DeleteSourceCredentialsInput{
 		Arn: aws.String(d.Id()),
@@ -90,7 +90,7 @@ func TestAccAWSCodeBuildSourceCredential_BasicAuth(t *testing.T) {
 }
 
 func testAccCheckAWSCodeBuildSourceCredentialDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+	conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codebuild_source_credential" {
@@ -122,7 +122,7 @@ func testAccCheckAWSCodeBuildSourceCredentialExists(name string, sourceCredentia
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codebuildconn
+		conn := testAccProvider.Meta().(*AWSClient).codebuildconn()
 
 		resp, err := conn.ListSourceCredentials(&codebuild.ListSourceCredentialsInput{})
 		if err != nil {
@@ -562,7 +562,7 @@ func testAccCheckAWSCodePipelineExists(n string, pipeline *codepipeline.Pipeline
 			return fmt.Errorf("No CodePipeline ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codepipelineconn
+		conn := testAccProvider.Meta().(*AWSClient).codepipelineconn()
 
 		out, err := conn.GetPipeline(&codepipeline.GetPipelineInput{
 			Name: aws.String(rs.Primary.ID),
@@ -578,7 +578,7 @@ func testAccCheckAWSCodePipelineExists(n string, pipeline *codepipeline.Pipeline
 }
 
 func testAccCheckAWSCodePipelineDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codepipelineconn
+	conn := testAccProvider.Meta().(*AWSClient).codepipelineconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_codepipeline" {
@@ -611,7 +611,7 @@ func testAccPreCheckAWSCodePipelineSupported(t *testing.T, regions ...string) {
 		if err != nil {
 			t.Fatalf("error getting AWS client for region %s", region)
 		}
-		conn := client.(*AWSClient).codepipelineconn
+		conn := client.(*AWSClient).codepipelineconn()
 
 		input := &codepipeline.ListPipelinesInput{}
 		_, err = conn.ListPipelines(input)
@@ -123,7 +123,7 @@ func testAccCheckAWSCodeStarConnectionsConnectionExists(n string, v *codestarcon
 			return errors.New("No CodeStar connection ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).codestarconnectionsconn
+		conn := testAccProvider.Meta().(*AWSClient).codestarconnectionsconn()
 
 		resp, err := conn.GetConnection(&codestarconnections.GetConnectionInput{
 			ConnectionArn: aws.String(rs.Primary.ID),
@@ -139,7 +139,7 @@ func testAccCheckAWSCodeStarConnectionsConnectionExists(n string, v *codestarcon
 }
 
 func testAccCheckAWSCodeStarConnectionsConnectionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codestarconnectionsconn
+	conn := testAccProvider.Meta().(*AWSClient).codestarconnectionsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		switch rs.Type {
@@ -198,7 +198,7 @@ func TestAccAWSCodeStarNotificationsNotificationRule_EventTypeIds(t *testing.T)
 }
 
 func testAccCheckAWSCodeStarNotificationsNotificationRuleDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).codestarnotificationsconn
+	conn := testAccProvider.Meta().(*AWSClient).codestarnotificationsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		switch rs.Type {
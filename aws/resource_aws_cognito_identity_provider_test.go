@@ -73,7 +73,7 @@ func TestAccAWSCognitoIdentityProvider_basic(t *testing.T) {
 }
 
 func testAccCheckAWSCognitoIdentityProviderDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_identity_provider" {
@@ -113,7 +113,7 @@ func testAccCheckAWSCognitoIdentityProviderExists(resourceName string, identityP
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 		input := &cognitoidentityprovider.DescribeIdentityProviderInput{
 			ProviderName: aws.String(providerName),
@@ -541,7 +541,7 @@ func testAccAWSCognitoUserPoolClientImportStateIDFunc(resourceName string) resou
 			return "", errors.New("No Cognito User Pool Client ID set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 		userPoolId := rs.Primary.Attributes["user_pool_id"]
 		clientId := rs.Primary.ID
 
@@ -561,7 +561,7 @@ func testAccAWSCognitoUserPoolClientImportStateIDFunc(resourceName string) resou
 }
 
 func testAccCheckAWSCognitoUserPoolClientDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cognito_user_pool_client" {
@@ -597,7 +597,7 @@ func testAccCheckAWSCognitoUserPoolClientExists(name string, client *cognitoiden
 			return errors.New("No Cognito User Pool Client ID set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn()
 
 		params := &cognitoidentityprovider.DescribeUserPoolClientInput{
 			ClientId:   aws.String(rs.Primary.ID),
@@ -73,7 +73,7 @@ func resourceAwsCognitoUserPoolUICustomization() *schema.Resource {
 }
 
 func resourceAwsCognitoUserPoolUICustomizationPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 
 	clientId := d.Get("client_id").(string)
 	userPoolId := d.Get("user_pool_id").(string)
@@ -108,7 +108,7 @@ func resourceAwsCognitoUserPoolUICustomizationPut(d *schema.ResourceData, meta i
 }
 
 func resourceAwsCognitoUserPoolUICustomizationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 
 	userPoolId, clientId, err := parseCognitoUserPoolUICustomizationID(d.Id())
 
@@ -150,7 +150,7 @@ func resourceAwsCognitoUserPoolUICustomizationRead(d *schema.ResourceData, meta
 }
 
 func resourceAwsCognitoUserPoolUICustomizationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cognitoidpconn
+	conn := meta.(*AWSClient).cognitoidpconn()
 
 	userPoolId, clientId, err := parseCognitoUserPoolUICustomizationID(d.Id())
 
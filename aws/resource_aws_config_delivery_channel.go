@@ -64,7 +64,7 @@ func resourceAwsConfigDeliveryChannel() *schema.Resource {
 }
 
 func resourceAwsConfigDeliveryChannelPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	name := d.Get("name").(string)
 	channel := configservice.DeliveryChannel{
@@ -117,7 +117,7 @@ func resourceAwsConfigDeliveryChannelPut(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsConfigDeliveryChannelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 
 	input := configservice.DescribeDeliveryChannelsInput{
 		DeliveryChannelNames: []*string{aws.String(d.Id())},
@@ -160,7 +160,7 @@ func resourceAwsConfigDeliveryChannelRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsConfigDeliveryChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).configconn
+	conn := meta.(*AWSClient).configconn()
 	input := configservice.DeleteDeliveryChannelInput{
 		DeliveryChannelName: aws.String(d.Id()),
 	}
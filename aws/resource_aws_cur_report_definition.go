@@ -115,7 +115,7 @@ func resourceAwsCurReportDefinition() *schema.Resource {
 }
 
 func resourceAwsCurReportDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).costandusagereportconn
+	conn := meta.(*AWSClient).costandusagereportconn()
 
 	additionalArtifacts := expandStringSet(d.Get("additional_artifacts").(*schema.Set))
 	compression := aws.String(d.Get("compression").(string))
@@ -170,7 +170,7 @@ func resourceAwsCurReportDefinitionCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsCurReportDefinitionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).costandusagereportconn
+	conn := meta.(*AWSClient).costandusagereportconn()
 
 	reportName := d.Id()
 
@@ -199,7 +199,7 @@ func resourceAwsCurReportDefinitionRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsCurReportDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).costandusagereportconn
+	conn := meta.(*AWSClient).costandusagereportconn()
 	log.Printf("[DEBUG] Deleting AWS Cost and Usage Report Definition : %s", d.Id())
 	_, err := conn.DeleteReportDefinition(&costandusagereportservice.DeleteReportDefinitionInput{
 		ReportName: aws.String(d.Id()),
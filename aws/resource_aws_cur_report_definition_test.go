@@ -236,7 +236,7 @@ func TestAccAwsCurReportDefinition_overwrite(t *testing.T) {
 }
 
 func testAccCheckAwsCurReportDefinitionDestroy(s *terraform.State) error {
-	conn := testAccProviderCur.Meta().(*AWSClient).costandusagereportconn
+	conn := testAccProviderCur.Meta().(*AWSClient).costandusagereportconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_cur_report_definition" {
@@ -257,7 +257,7 @@ func testAccCheckAwsCurReportDefinitionDestroy(s *terraform.State) error {
 
 func testAccCheckAwsCurReportDefinitionExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProviderCur.Meta().(*AWSClient).costandusagereportconn
+		conn := testAccProviderCur.Meta().(*AWSClient).costandusagereportconn()
 
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
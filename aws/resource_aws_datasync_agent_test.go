@@ -26,7 +26,7 @@ func testSweepDataSyncAgents(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).datasyncconn
+	conn := client.(*AWSClient).datasyncconn()
 
 	input := &datasync.ListAgentsInput{}
 	for {
@@ -210,7 +210,7 @@ func TestAccAWSDataSyncAgent_Tags(t *testing.T) {
 }
 
 func testAccCheckAWSDataSyncAgentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+	conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_datasync_agent" {
@@ -242,7 +242,7 @@ func testAccCheckAWSDataSyncAgentExists(resourceName string, agent *datasync.Des
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 		input := &datasync.DescribeAgentInput{
 			AgentArn: aws.String(rs.Primary.ID),
 		}
@@ -265,7 +265,7 @@ func testAccCheckAWSDataSyncAgentExists(resourceName string, agent *datasync.Des
 
 func testAccCheckAWSDataSyncAgentDisappears(agent *datasync.DescribeAgentOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).datasyncconn
+		conn := testAccProvider.Meta().(*AWSClient).datasyncconn()
 
 		input := &datasync.DeleteAgentInput{
 			AgentArn: agent.AgentArn,
@@ -52,7 +52,7 @@ func resourceAwsDaxParameterGroup() *schema.Resource {
 }
 
 func resourceAwsDaxParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).daxconn
+	conn := meta.(*AWSClient).daxconn()
 
 	input := &dax.CreateParameterGroupInput{
 		ParameterGroupName: aws.String(d.Get("name").(string)),
@@ -75,7 +75,7 @@ func resourceAwsDaxParameterGroupCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDaxParameterGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).daxconn
+	conn := meta.(*AWSClient).daxconn()
 
 	resp, err := conn.DescribeParameterGroups(&dax.DescribeParameterGroupsInput{
 		ParameterGroupNames: []*string{aws.String(d.Id())},
@@ -121,7 +121,7 @@ func resourceAwsDaxParameterGroupRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDaxParameterGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).daxconn
+	conn := meta.(*AWSClient).daxconn()
 
 	input := &dax.UpdateParameterGroupInput{
 		ParameterGroupName: aws.String(d.Id()),
@@ -142,7 +142,7 @@ func resourceAwsDaxParameterGroupUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDaxParameterGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).daxconn
+	conn := meta.(*AWSClient).daxconn()
 
 	input := &dax.DeleteParameterGroupInput{
 		ParameterGroupName: aws.String(d.Id()),
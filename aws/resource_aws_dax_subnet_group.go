@@ -44,7 +44,7 @@ func resourceAwsDaxSubnetGroup() *schema.Resource {
 }
 
 func resourceAwsDaxSubnetGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).daxconn
+	conn := meta.(*AWSClient).daxconn()
 
 	input := &dax.CreateSubnetGroupInput{
 		SubnetGroupName: aws.String(d.Get("name").(string)),
@@ -64,7 +64,7 @@ func resourceAwsDaxSubnetGroupCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsDaxSubnetGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).daxconn
+	conn := meta.(*AWSClient).daxconn()
 
 	resp, err := conn.DescribeSubnetGroups(&dax.DescribeSubnetGroupsInput{
 		SubnetGroupNames: []*string{aws.String(d.Id())},
@@ -91,7 +91,7 @@ func resourceAwsDaxSubnetGroupRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsDaxSubnetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).daxconn
+	conn := meta.(*AWSClient).daxconn()
 
 	input := &dax.UpdateSubnetGroupInput{
 		SubnetGroupName: aws.String(d.Id()),
@@ -114,7 +114,7 @@ func resourceAwsDaxSubnetGroupUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsDaxSubnetGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).daxconn
+	conn := meta.(*AWSClient).daxconn()
 
 	input := &dax.DeleteSubnetGroupInput{
 		SubnetGroupName: aws.String(d.Id()),
@@ -56,7 +56,7 @@ func resourceAwsDefaultSubnet() *schema.Resource {
 }
 
 func resourceAwsDefaultSubnetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	req := &ec2.DescribeSubnetsInput{}
 	req.Filters = buildEC2AttributeFilterList(
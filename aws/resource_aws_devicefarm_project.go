@@ -34,7 +34,7 @@ func resourceAwsDevicefarmProject() *schema.Resource {
 }
 
 func resourceAwsDevicefarmProjectCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).devicefarmconn
+	conn := meta.(*AWSClient).devicefarmconn()
 
 	input := &devicefarm.CreateProjectInput{
 		Name: aws.String(d.Get("name").(string)),
@@ -53,7 +53,7 @@ func resourceAwsDevicefarmProjectCreate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDevicefarmProjectRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).devicefarmconn
+	conn := meta.(*AWSClient).devicefarmconn()
 
 	input := &devicefarm.GetProjectInput{
 		Arn: aws.String(d.Id()),
@@ -77,7 +77,7 @@ func resourceAwsDevicefarmProjectRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDevicefarmProjectUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).devicefarmconn
+	conn := meta.(*AWSClient).devicefarmconn()
 
 	if d.HasChange("name") {
 		input := &devicefarm.UpdateProjectInput{
@@ -97,7 +97,7 @@ func resourceAwsDevicefarmProjectUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDevicefarmProjectDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).devicefarmconn
+	conn := meta.(*AWSClient).devicefarmconn()
 
 	input := &devicefarm.DeleteProjectInput{
 		Arn: aws.String(d.Id()),
@@ -594,7 +594,7 @@ func checkDmsEndpointExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dmsconn
+		conn := testAccProvider.Meta().(*AWSClient).dmsconn()
 		resp, err := conn.DescribeEndpoints(&dms.DescribeEndpointsInput{
 			Filters: []*dms.Filter{
 				{
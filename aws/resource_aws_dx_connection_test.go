@@ -27,7 +27,7 @@ func testSweepDxConnections(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).dxconn
+	conn := client.(*AWSClient).dxconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -144,7 +144,7 @@ func TestAccAWSDxConnection_tags(t *testing.T) {
 }
 
 func testAccCheckAwsDxConnectionDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_connection" {
@@ -76,7 +76,7 @@ func resourceAwsDxGatewayAssociationResourceV0() *schema.Resource {
 }
 
 func resourceAwsDxGatewayAssociationStateUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	log.Println("[INFO] Found Direct Connect gateway association state v0; migrating to v1")
 
@@ -24,7 +24,7 @@ func resourceAwsDxGatewayAssociationProposal() *schema.Resource {
 			// Accepting the proposal with overridden prefixes changes the returned RequestedAllowedPrefixesToDirectConnectGateway value (allowed_prefixes attribute).
 			// We only want to force a new resource if this value changes and the current proposal state is "requested".
 			customdiff.ForceNewIf("allowed_prefixes", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
-				conn := meta.(*AWSClient).dxconn
+				conn := meta.(*AWSClient).dxconn()
 
 				proposal, err := describeDirectConnectGatewayAssociationProposal(conn, d.Id())
 				if err != nil {
@@ -72,7 +72,7 @@ func resourceAwsDxGatewayAssociationProposal() *schema.Resource {
 }
 
 func resourceAwsDxGatewayAssociationProposalCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	allowedPrefixes := expandDirectConnectGatewayAssociationProposalAllowedPrefixes(d.Get("allowed_prefixes").(*schema.Set).List())
 	input := &directconnect.CreateDirectConnectGatewayAssociationProposalInput{
@@ -95,7 +95,7 @@ func resourceAwsDxGatewayAssociationProposalCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsDxGatewayAssociationProposalRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	proposal, err := describeDirectConnectGatewayAssociationProposal(conn, d.Id())
 
@@ -133,7 +133,7 @@ func resourceAwsDxGatewayAssociationProposalRead(d *schema.ResourceData, meta in
 }
 
 func resourceAwsDxGatewayAssociationProposalDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dxconn
+	conn := meta.(*AWSClient).dxconn()
 
 	input := &directconnect.DeleteDirectConnectGatewayAssociationProposalInput{
 		ProposalId: aws.String(d.Id()),
@@ -27,7 +27,7 @@ func testSweepDirectConnectGatewayAssociationProposals(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).dxconn
+	conn := client.(*AWSClient).dxconn()
 	input := &directconnect.DescribeDirectConnectGatewayAssociationProposalsInput{}
 
 	for {
@@ -227,7 +227,7 @@ func TestAccAwsDxGatewayAssociationProposal_AllowedPrefixes(t *testing.T) {
 }
 
 func testAccCheckAwsDxGatewayAssociationProposalDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_gateway_association_proposal" {
@@ -257,7 +257,7 @@ func testAccCheckAwsDxGatewayAssociationProposalExists(resourceName string, gate
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dxconn
+		conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 		proposal, err := describeDirectConnectGatewayAssociationProposal(conn, rs.Primary.ID)
 
@@ -277,7 +277,7 @@ func testAccCheckAwsDxGatewayAssociationProposalExists(resourceName string, gate
 
 func testAccCheckAwsDxGatewayAssociationProposalDisappears(proposal *directconnect.GatewayAssociationProposal) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).dxconn
+		conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 		input := &directconnect.DeleteDirectConnectGatewayAssociationProposalInput{
 			ProposalId: proposal.ProposalId,
@@ -28,7 +28,7 @@ func testSweepDxLags(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).dxconn
+	conn := client.(*AWSClient).dxconn()
 
 	var sweeperErrs *multierror.Error
 
@@ -166,7 +166,7 @@ func TestAccAWSDxLag_tags(t *testing.T) {
 }
 
 func testAccCheckAwsDxLagDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dxconn
+	conn := testAccProvider.Meta().(*AWSClient).dxconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dx_lag" {
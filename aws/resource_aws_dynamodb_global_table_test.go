@@ -109,7 +109,7 @@ func TestAccAWSDynamoDbGlobalTable_multipleRegions(t *testing.T) {
 }
 
 func testAccCheckAwsDynamoDbGlobalTableDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dynamodb_global_table" {
@@ -146,7 +146,7 @@ func testAccCheckAwsDynamoDbGlobalTableExists(name string) resource.TestCheckFun
 }
 
 func testAccPreCheckAWSDynamodbGlobalTable(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 	input := &dynamodb.ListGlobalTablesInput{}
 
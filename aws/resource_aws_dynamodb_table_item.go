@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"encoding/base64"
 	"fmt"
 	"log"
 	"reflect"
@@ -9,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfdynamodb "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/dynamodb"
 )
 
 func resourceAwsDynamoDbTableItem() *schema.Resource {
@@ -18,6 +20,10 @@ func resourceAwsDynamoDbTableItem() *schema.Resource {
 		Update: resourceAwsDynamoDbTableItemUpdate,
 		Delete: resourceAwsDynamoDbTableItemDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsDynamoDbTableItemImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"table_name": {
 				Type:     schema.TypeString,
@@ -35,9 +41,10 @@ func resourceAwsDynamoDbTableItem() *schema.Resource {
 				Optional: true,
 			},
 			"item": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validateDynamoDbTableItem,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateDynamoDbTableItem,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
 		},
 	}
@@ -52,7 +59,7 @@ func validateDynamoDbTableItem(v interface{}, k string) (ws []string, errors []e
 }
 
 func resourceAwsDynamoDbTableItemCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	tableName := d.Get("table_name").(string)
 	hashKey := d.Get("hash_key").(string)
@@ -88,7 +95,7 @@ func resourceAwsDynamoDbTableItemCreate(d *schema.ResourceData, meta interface{}
 
 func resourceAwsDynamoDbTableItemUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Updating DynamoDB table %s", d.Id())
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	if d.HasChange("item") {
 		tableName := d.Get("table_name").(string)
@@ -153,7 +160,7 @@ func resourceAwsDynamoDbTableItemUpdate(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsDynamoDbTableItemRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	log.Printf("[DEBUG] Loading data for DynamoDB table item '%s'", d.Id())
 
@@ -203,7 +210,7 @@ func resourceAwsDynamoDbTableItemRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsDynamoDbTableItemDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dynamodbconn
+	conn := meta.(*AWSClient).dynamodbconn()
 
 	attributes, err := expandDynamoDbTableItemAttributes(d.Get("item").(string))
 	if err != nil {
@@ -220,8 +227,108 @@ func resourceAwsDynamoDbTableItemDelete(d *schema.ResourceData, meta interface{}
 	return err
 }
 
+func resourceAwsDynamoDbTableItemImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).dynamodbconn()
+
+	tableName, hashKeyValue, rangeKeyValue, err := tfdynamodb.TableItemParseImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := conn.DescribeTable(&dynamodb.DescribeTableInput{
+		TableName: aws.String(tableName),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error describing DynamoDB Table (%s): %w", tableName, err)
+	}
+
+	if output == nil || output.Table == nil {
+		return nil, fmt.Errorf("error describing DynamoDB Table (%s): empty response", tableName)
+	}
+
+	attributeTypes := make(map[string]string)
+	for _, definition := range output.Table.AttributeDefinitions {
+		attributeTypes[aws.StringValue(definition.AttributeName)] = aws.StringValue(definition.AttributeType)
+	}
+
+	var hashKey, rangeKey string
+	for _, element := range output.Table.KeySchema {
+		switch aws.StringValue(element.KeyType) {
+		case dynamodb.KeyTypeHash:
+			hashKey = aws.StringValue(element.AttributeName)
+		case dynamodb.KeyTypeRange:
+			rangeKey = aws.StringValue(element.AttributeName)
+		}
+	}
+
+	if (rangeKey == "") != (rangeKeyValue == "") {
+		return nil, fmt.Errorf("unexpected format for ID (%q): DynamoDB Table (%s) range key (%s) value must be specified if and only if the table has a range key", d.Id(), tableName, rangeKey)
+	}
+
+	key := make(map[string]*dynamodb.AttributeValue)
+
+	key[hashKey], err = expandDynamoDbTableItemKeyValue(attributeTypes[hashKey], hashKeyValue)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing DynamoDB Table (%s) hash key (%s) value: %w", tableName, hashKey, err)
+	}
+
+	if rangeKey != "" {
+		key[rangeKey], err = expandDynamoDbTableItemKeyValue(attributeTypes[rangeKey], rangeKeyValue)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing DynamoDB Table (%s) range key (%s) value: %w", tableName, rangeKey, err)
+		}
+	}
+
+	result, err := conn.GetItem(&dynamodb.GetItemInput{
+		TableName:      aws.String(tableName),
+		ConsistentRead: aws.Bool(true),
+		Key:            key,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading DynamoDB Table (%s) item: %w", tableName, err)
+	}
+
+	if result.Item == nil {
+		return nil, fmt.Errorf("error reading DynamoDB Table (%s) item (%s): not found", tableName, d.Id())
+	}
+
+	item, err := flattenDynamoDbTableItemAttributes(result.Item)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(buildDynamoDbTableItemId(tableName, hashKey, rangeKey, result.Item))
+	d.Set("table_name", tableName)
+	d.Set("hash_key", hashKey)
+	d.Set("range_key", rangeKey)
+	d.Set("item", item)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // Helpers
 
+// expandDynamoDbTableItemKeyValue returns the key attribute value of the given scalar type.
+// Binary values are expected to be base64 encoded.
+func expandDynamoDbTableItemKeyValue(attributeType, value string) (*dynamodb.AttributeValue, error) {
+	switch attributeType {
+	case dynamodb.ScalarAttributeTypeS:
+		return &dynamodb.AttributeValue{S: aws.String(value)}, nil
+	case dynamodb.ScalarAttributeTypeN:
+		return &dynamodb.AttributeValue{N: aws.String(value)}, nil
+	case dynamodb.ScalarAttributeTypeB:
+		b, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding binary value: %w", err)
+		}
+		return &dynamodb.AttributeValue{B: b}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported attribute type (%s)", attributeType)
+}
+
 func buildDynamoDbExpressionAttributeNames(attrs map[string]*dynamodb.AttributeValue) map[string]*string {
 	names := map[string]*string{}
 	for key := range attrs {
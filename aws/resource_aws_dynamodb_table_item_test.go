@@ -40,6 +40,13 @@ func TestAccAWSDynamoDbTableItem_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_dynamodb_table_item.test", "item", itemContent+"\n"),
 				),
 			},
+			{
+				ResourceName:            "aws_dynamodb_table_item.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s|something", tableName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"item"},
+			},
 		},
 	})
 }
@@ -76,6 +83,13 @@ func TestAccAWSDynamoDbTableItem_rangeKey(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_dynamodb_table_item.test", "item", itemContent+"\n"),
 				),
 			},
+			{
+				ResourceName:            "aws_dynamodb_table_item.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s|something|something-else", tableName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"item"},
+			},
 		},
 	})
 }
@@ -232,7 +246,7 @@ func TestAccAWSDynamoDbTableItem_updateWithRangeKey(t *testing.T) {
 }
 
 func testAccCheckAWSDynamoDbItemDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+	conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_dynamodb_table_item" {
@@ -279,7 +293,7 @@ func testAccCheckAWSDynamoDbTableItemExists(n string, item *dynamodb.GetItemOutp
 			return fmt.Errorf("No DynamoDB table item ID specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 
 		attrs := rs.Primary.Attributes
 		attributes, err := expandDynamoDbTableItemAttributes(attrs["item"])
@@ -306,7 +320,7 @@ func testAccCheckAWSDynamoDbTableItemExists(n string, item *dynamodb.GetItemOutp
 
 func testAccCheckAWSDynamoDbTableItemCount(tableName string, count int64) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn
+		conn := testAccProvider.Meta().(*AWSClient).dynamodbconn()
 		out, err := conn.Scan(&dynamodb.ScanInput{
 			ConsistentRead: aws.Bool(true),
 			TableName:      aws.String(tableName),
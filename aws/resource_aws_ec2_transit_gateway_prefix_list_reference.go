@@ -55,7 +55,7 @@ func resourceAwsEc2TransitGatewayPrefixListReference() *schema.Resource {
 }
 
 func resourceAwsEc2TransitGatewayPrefixListReferenceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.CreateTransitGatewayPrefixListReferenceInput{}
 
@@ -95,7 +95,7 @@ func resourceAwsEc2TransitGatewayPrefixListReferenceCreate(d *schema.ResourceDat
 }
 
 func resourceAwsEc2TransitGatewayPrefixListReferenceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	transitGatewayPrefixListReference, err := finder.TransitGatewayPrefixListReferenceByID(conn, d.Id())
 
@@ -137,7 +137,7 @@ func resourceAwsEc2TransitGatewayPrefixListReferenceRead(d *schema.ResourceData,
 }
 
 func resourceAwsEc2TransitGatewayPrefixListReferenceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	input := &ec2.ModifyTransitGatewayPrefixListReferenceInput{}
 
@@ -175,7 +175,7 @@ func resourceAwsEc2TransitGatewayPrefixListReferenceUpdate(d *schema.ResourceDat
 }
 
 func resourceAwsEc2TransitGatewayPrefixListReferenceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	transitGatewayRouteTableID, prefixListID, err := tfec2.TransitGatewayPrefixListReferenceParseID(d.Id())
 
@@ -25,7 +25,7 @@ func testSweepEcrPublicRepositories(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).ecrpublicconn
+	conn := client.(*AWSClient).ecrpublicconn()
 
 	var errors error
 	err = conn.DescribeRepositoriesPages(&ecrpublic.DescribeRepositoriesInput{}, func(page *ecrpublic.DescribeRepositoriesOutput, isLast bool) bool {
@@ -368,7 +368,7 @@ func testAccCheckAWSEcrPublicRepositoryExists(name string, res *ecrpublic.Reposi
 			return fmt.Errorf("No ECR Public repository ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+		conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn()
 
 		output, err := conn.DescribeRepositories(&ecrpublic.DescribeRepositoriesInput{
 			RepositoryNames: aws.StringSlice([]string{rs.Primary.ID}),
@@ -387,7 +387,7 @@ func testAccCheckAWSEcrPublicRepositoryExists(name string, res *ecrpublic.Reposi
 }
 
 func testAccCheckAWSEcrPublicRepositoryDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ecrpublic_repository" {
@@ -502,7 +502,7 @@ resource "aws_ecrpublic_repository" "test" {
 }
 
 func testAccPreCheckAwsEcrPublic(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn
+	conn := testAccProvider.Meta().(*AWSClient).ecrpublicconn()
 	input := &ecrpublic.DescribeRepositoriesInput{}
 	_, err := conn.DescribeRepositories(input)
 	if testAccPreCheckSkipError(err) {
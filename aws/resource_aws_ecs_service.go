@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ecs/waiter"
 )
 
 func resourceAwsEcsService() *schema.Resource {
@@ -29,9 +30,13 @@ func resourceAwsEcsService() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.ServiceDeploymentCompletedTimeout),
+			Update: schema.DefaultTimeout(waiter.ServiceDeploymentCompletedTimeout),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -167,6 +172,24 @@ func resourceAwsEcsService() *schema.Resource {
 				},
 			},
 
+			"deployment_circuit_breaker": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"rollback": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"deployment_maximum_percent": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -352,7 +375,14 @@ func resourceAwsEcsService() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
+
+			"wait_for_deployment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 
 			"wait_for_steady_state": {
 				Type:     schema.TypeBool,
@@ -384,7 +414,10 @@ func resourceAwsEcsServiceImport(d *schema.ResourceData, meta interface{}) ([]*s
 }
 
 func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
+
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	deploymentMinimumHealthyPercent := d.Get("deployment_minimum_healthy_percent").(int)
 	schedulingStrategy := d.Get("scheduling_strategy").(string)
@@ -395,7 +428,7 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 		DeploymentController: deploymentController,
 		SchedulingStrategy:   aws.String(schedulingStrategy),
 		ServiceName:          aws.String(d.Get("name").(string)),
-		Tags:                 keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().EcsTags(),
+		Tags:                 tags.IgnoreAws().EcsTags(),
 		TaskDefinition:       aws.String(d.Get("task_definition").(string)),
 		EnableECSManagedTags: aws.Bool(d.Get("enable_ecs_managed_tags").(bool)),
 	}
@@ -406,8 +439,9 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	} else if schedulingStrategy == ecs.SchedulingStrategyReplica {
 		input.DeploymentConfiguration = &ecs.DeploymentConfiguration{
-			MaximumPercent:        aws.Int64(int64(d.Get("deployment_maximum_percent").(int))),
-			MinimumHealthyPercent: aws.Int64(int64(deploymentMinimumHealthyPercent)),
+			DeploymentCircuitBreaker: expandEcsDeploymentCircuitBreaker(d.Get("deployment_circuit_breaker").([]interface{})),
+			MaximumPercent:           aws.Int64(int64(d.Get("deployment_maximum_percent").(int))),
+			MinimumHealthyPercent:    aws.Int64(int64(deploymentMinimumHealthyPercent)),
 		}
 		input.DesiredCount = aws.Int64(int64(d.Get("desired_count").(int)))
 	}
@@ -534,7 +568,11 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] ECS service created: %s", aws.StringValue(service.ServiceArn))
 	d.SetId(aws.StringValue(service.ServiceArn))
 
-	if d.Get("wait_for_steady_state").(bool) {
+	if d.Get("wait_for_deployment").(bool) {
+		if err := waitForEcsServiceDeployment(conn, d, &service, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	} else if d.Get("wait_for_steady_state").(bool) {
 		if err := waitForSteadyState(conn, d); err != nil {
 			return err
 		}
@@ -544,7 +582,8 @@ func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	log.Printf("[DEBUG] Reading ECS service %s", d.Id())
@@ -660,6 +699,10 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 	if service.DeploymentConfiguration != nil {
 		d.Set("deployment_maximum_percent", service.DeploymentConfiguration.MaximumPercent)
 		d.Set("deployment_minimum_healthy_percent", service.DeploymentConfiguration.MinimumHealthyPercent)
+
+		if err := d.Set("deployment_circuit_breaker", flattenEcsDeploymentCircuitBreaker(service.DeploymentConfiguration.DeploymentCircuitBreaker)); err != nil {
+			return fmt.Errorf("error setting deployment_circuit_breaker for (%s): %w", d.Id(), err)
+		}
 	}
 
 	if err := d.Set("deployment_controller", flattenEcsDeploymentController(service.DeploymentController)); err != nil {
@@ -690,10 +733,17 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting service_registries for (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("tags", keyvaluetags.EcsKeyValueTags(service.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.EcsKeyValueTags(service.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
@@ -725,6 +775,33 @@ func flattenEcsDeploymentController(deploymentController *ecs.DeploymentControll
 	return []interface{}{m}
 }
 
+func expandEcsDeploymentCircuitBreaker(l []interface{}) *ecs.DeploymentCircuitBreaker {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &ecs.DeploymentCircuitBreaker{
+		Enable:   aws.Bool(m["enable"].(bool)),
+		Rollback: aws.Bool(m["rollback"].(bool)),
+	}
+}
+
+func flattenEcsDeploymentCircuitBreaker(circuitBreaker *ecs.DeploymentCircuitBreaker) []interface{} {
+	// A disabled circuit breaker is returned when none is configured.
+	if circuitBreaker == nil || (!aws.BoolValue(circuitBreaker.Enable) && !aws.BoolValue(circuitBreaker.Rollback)) {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"enable":   aws.BoolValue(circuitBreaker.Enable),
+		"rollback": aws.BoolValue(circuitBreaker.Rollback),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenEcsNetworkConfiguration(nc *ecs.NetworkConfiguration) []interface{} {
 	if nc == nil {
 		return nil
@@ -938,7 +1015,7 @@ func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface
 }
 
 func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 	updateService := false
 
 	input := ecs.UpdateServiceInput{
@@ -962,11 +1039,20 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 			input.DesiredCount = aws.Int64(int64(d.Get("desired_count").(int)))
 		}
 
-		if d.HasChanges("deployment_maximum_percent", "deployment_minimum_healthy_percent") {
+		if d.HasChanges("deployment_circuit_breaker", "deployment_maximum_percent", "deployment_minimum_healthy_percent") {
 			updateService = true
 			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{
-				MaximumPercent:        aws.Int64(int64(d.Get("deployment_maximum_percent").(int))),
-				MinimumHealthyPercent: aws.Int64(int64(d.Get("deployment_minimum_healthy_percent").(int))),
+				DeploymentCircuitBreaker: expandEcsDeploymentCircuitBreaker(d.Get("deployment_circuit_breaker").([]interface{})),
+				MaximumPercent:           aws.Int64(int64(d.Get("deployment_maximum_percent").(int))),
+				MinimumHealthyPercent:    aws.Int64(int64(d.Get("deployment_minimum_healthy_percent").(int))),
+			}
+
+			// Disabling requires an explicit circuit breaker configuration.
+			if input.DeploymentConfiguration.DeploymentCircuitBreaker == nil {
+				input.DeploymentConfiguration.DeploymentCircuitBreaker = &ecs.DeploymentCircuitBreaker{
+					Enable:   aws.Bool(false),
+					Rollback: aws.Bool(false),
+				}
 			}
 		}
 	}
@@ -1030,11 +1116,15 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		input.CapacityProviderStrategy = expandEcsCapacityProviderStrategy(d.Get("capacity_provider_strategy").(*schema.Set))
 	}
 
+	var service *ecs.Service
+
 	if updateService {
 		log.Printf("[DEBUG] Updating ECS Service (%s): %s", d.Id(), input)
 		// Retry due to IAM eventual consistency
+		var out *ecs.UpdateServiceOutput
 		err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-			_, err := conn.UpdateService(&input)
+			var err error
+			out, err = conn.UpdateService(&input)
 			if err != nil {
 				if isAWSErr(err, ecs.ErrCodeInvalidParameterException, "Please verify that the ECS service role being passed has the proper permissions.") {
 					return resource.RetryableError(err)
@@ -1047,21 +1137,29 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 			return nil
 		})
 		if isResourceTimeoutError(err) {
-			_, err = conn.UpdateService(&input)
+			out, err = conn.UpdateService(&input)
 		}
 		if err != nil {
 			return fmt.Errorf("Error updating ECS Service (%s): %s", d.Id(), err)
 		}
+
+		service = out.Service
 	}
 
-	if d.Get("wait_for_steady_state").(bool) {
+	if d.Get("wait_for_deployment").(bool) {
+		if service != nil {
+			if err := waitForEcsServiceDeployment(conn, d, service, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	} else if d.Get("wait_for_steady_state").(bool) {
 		if err := waitForSteadyState(conn, d); err != nil {
 			return err
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.EcsUpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating ECS Service (%s) tags: %s", d.Id(), err)
@@ -1072,7 +1170,7 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsEcsServiceDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ecsconn
+	conn := meta.(*AWSClient).ecsconn()
 
 	// Check if it's not already gone
 	resp, err := conn.DescribeServices(&ecs.DescribeServicesInput{
@@ -1203,3 +1301,30 @@ func waitForSteadyState(conn *ecs.ECS, d *schema.ResourceData) error {
 	}
 	return nil
 }
+
+// Status of the current deployment of a service
+const ecsServiceDeploymentStatusPrimary = "PRIMARY"
+
+// waitForEcsServiceDeployment waits for the primary deployment of the service to complete.
+// A failed deployment is reported with the service events and stop reasons of its tasks.
+func waitForEcsServiceDeployment(conn *ecs.ECS, d *schema.ResourceData, service *ecs.Service, timeout time.Duration) error {
+	var deploymentID string
+
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Status) == ecsServiceDeploymentStatusPrimary {
+			deploymentID = aws.StringValue(deployment.Id)
+			break
+		}
+	}
+
+	if deploymentID == "" {
+		log.Printf("[WARN] ECS Service (%s) has no primary deployment to wait for", d.Id())
+		return nil
+	}
+
+	if _, err := waiter.ServiceDeploymentCompleted(conn, d.Get("cluster").(string), d.Id(), deploymentID, timeout); err != nil {
+		return fmt.Errorf("error waiting for ECS Service (%s) deployment: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -33,7 +33,7 @@ func testSweepEksClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).eksconn
+	conn := client.(*AWSClient).eksconn()
 
 	var errors error
 	input := &eks.ListClustersInput{}
@@ -508,7 +508,7 @@ func testAccCheckAWSEksClusterExists(resourceName string, cluster *eks.Cluster)
 			return fmt.Errorf("No EKS Cluster ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 		output, err := conn.DescribeCluster(&eks.DescribeClusterInput{
 			Name: aws.String(rs.Primary.ID),
 		})
@@ -536,7 +536,7 @@ func testAccCheckAWSEksClusterDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 		// Handle eventual consistency
 		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
@@ -585,7 +585,7 @@ func testAccCheckAWSEksClusterNotRecreated(i, j *eks.Cluster) resource.TestCheck
 }
 
 func testAccPreCheckAWSEks(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).eksconn
+	conn := testAccProvider.Meta().(*AWSClient).eksconn()
 
 	input := &eks.ListClustersInput{}
 
@@ -31,6 +31,8 @@ func resourceAwsEksNodeGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"ami_type": {
 				Type:     schema.TypeString,
@@ -209,7 +211,8 @@ func resourceAwsEksNodeGroup() *schema.Resource {
 				MinItems: 1,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 			"version": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -220,7 +223,10 @@ func resourceAwsEksNodeGroup() *schema.Resource {
 }
 
 func resourceAwsEksNodeGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
+
 	clusterName := d.Get("cluster_name").(string)
 	nodeGroupName := d.Get("node_group_name").(string)
 
@@ -268,18 +274,26 @@ func resourceAwsEksNodeGroupCreate(d *schema.ResourceData, meta interface{}) err
 		input.ScalingConfig = expandEksNodegroupScalingConfig(v)
 	}
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
-		input.Tags = keyvaluetags.New(v).IgnoreAws().EksTags()
+	if len(tags) > 0 {
+		input.Tags = tags.IgnoreAws().EksTags()
 	}
 
 	if v, ok := d.GetOk("version"); ok {
 		input.Version = aws.String(v.(string))
 	}
 
-	_, err := conn.CreateNodegroup(input)
-
 	id := fmt.Sprintf("%s:%s", clusterName, nodeGroupName)
 
+	release, err := meta.(*AWSClient).AcquireConcurrentOperation("eks", d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error creating EKS Node Group (%s): %w", id, err)
+	}
+
+	defer release()
+
+	_, err = conn.CreateNodegroup(input)
+
 	if err != nil {
 		return fmt.Errorf("error creating EKS Node Group (%s): %s", id, err)
 	}
@@ -301,7 +315,8 @@ func resourceAwsEksNodeGroupCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsEksNodeGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	clusterName, nodeGroupName, err := resourceAwsEksNodeGroupParseId(d.Id())
@@ -373,23 +388,38 @@ func resourceAwsEksNodeGroupRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error setting subnets: %s", err)
 	}
 
-	if err := d.Set("tags", keyvaluetags.EksKeyValueTags(nodeGroup.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.EksKeyValueTags(nodeGroup.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	d.Set("version", nodeGroup.Version)
 
 	return nil
 }
 
 func resourceAwsEksNodeGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	clusterName, nodeGroupName, err := resourceAwsEksNodeGroupParseId(d.Id())
 	if err != nil {
 		return err
 	}
 
+	release, err := meta.(*AWSClient).AcquireConcurrentOperation("eks", d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("error updating EKS Node Group (%s): %w", d.Id(), err)
+	}
+
+	defer release()
+
 	if d.HasChanges("labels", "scaling_config") {
 		oldLabelsRaw, newLabelsRaw := d.GetChange("labels")
 
@@ -476,8 +506,8 @@ func resourceAwsEksNodeGroupUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.EksUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -487,13 +517,21 @@ func resourceAwsEksNodeGroupUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsEksNodeGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).eksconn
+	conn := meta.(*AWSClient).eksconn()
 
 	clusterName, nodeGroupName, err := resourceAwsEksNodeGroupParseId(d.Id())
 	if err != nil {
 		return err
 	}
 
+	release, err := meta.(*AWSClient).AcquireConcurrentOperation("eks", d.Timeout(schema.TimeoutDelete))
+
+	if err != nil {
+		return fmt.Errorf("error deleting EKS Node Group (%s): %w", d.Id(), err)
+	}
+
+	defer release()
+
 	input := &eks.DeleteNodegroupInput{
 		ClusterName:   aws.String(clusterName),
 		NodegroupName: aws.String(nodeGroupName),
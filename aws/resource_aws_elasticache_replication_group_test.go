@@ -35,7 +35,7 @@ func testSweepElasticacheReplicationGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).elasticacheconn
+	conn := client.(*AWSClient).elasticacheconn()
 
 	err = conn.DescribeReplicationGroupsPages(&elasticache.DescribeReplicationGroupsInput{}, func(page *elasticache.DescribeReplicationGroupsOutput, isLast bool) bool {
 		if len(page.ReplicationGroups) == 0 {
@@ -1009,7 +1009,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_Failover_AutoFail
 			{
 				PreConfig: func() {
 					// Ensure that primary is on the node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					if err := resourceAwsElasticacheReplicationGroupSetPrimaryClusterID(conn, rName, formatReplicationGroupClusterID(rName, 3), timeout); err != nil {
@@ -1056,7 +1056,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_Failover_AutoFail
 			{
 				PreConfig: func() {
 					// Ensure that primary is on the node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					// Must disable automatic failover first
@@ -1114,7 +1114,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MultiAZEnabled(t
 			{
 				PreConfig: func() {
 					// Ensure that primary is on the node we are trying to delete
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					// Must disable automatic failover first
@@ -1167,7 +1167,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MemberClusterDisa
 			{
 				PreConfig: func() {
 					// Remove one of the Cache Clusters
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					cacheClusterID := formatReplicationGroupClusterID(rName, 2)
@@ -1213,7 +1213,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MemberClusterDisa
 			{
 				PreConfig: func() {
 					// Remove one of the Cache Clusters
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					cacheClusterID := formatReplicationGroupClusterID(rName, 2)
@@ -1259,7 +1259,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MemberClusterDisa
 			{
 				PreConfig: func() {
 					// Remove one of the Cache Clusters
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					cacheClusterID := formatReplicationGroupClusterID(rName, 2)
@@ -1305,7 +1305,7 @@ func TestAccAWSElasticacheReplicationGroup_NumberCacheClusters_MemberClusterDisa
 			{
 				PreConfig: func() {
 					// Remove one of the Cache Clusters
-					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+					conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 					timeout := 40 * time.Minute
 
 					cacheClusterID := formatReplicationGroupClusterID(rName, 2)
@@ -1560,7 +1560,7 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 			return fmt.Errorf("No replication group ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 		rg, err := finder.ReplicationGroupByID(conn, rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("ElastiCache error: %w", err)
@@ -1573,7 +1573,7 @@ func testAccCheckAWSElasticacheReplicationGroupExists(n string, v *elasticache.R
 }
 
 func testAccCheckAWSElasticacheReplicationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elasticache_replication_group" {
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfelb "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elb"
 )
 
 func resourceAwsElbAttachment() *schema.Resource {
@@ -17,6 +18,10 @@ func resourceAwsElbAttachment() *schema.Resource {
 		Read:   resourceAwsElbAttachmentRead,
 		Delete: resourceAwsElbAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElbAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"elb": {
 				Type:     schema.TypeString,
@@ -34,7 +39,7 @@ func resourceAwsElbAttachment() *schema.Resource {
 }
 
 func resourceAwsElbAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 	elbName := d.Get("elb").(string)
 
 	instance := d.Get("instance").(string)
@@ -73,7 +78,7 @@ func resourceAwsElbAttachmentCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsElbAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 	elbName := d.Get("elb").(string)
 
 	// only add the instance that was previously defined for this resource
@@ -116,8 +121,22 @@ func resourceAwsElbAttachmentRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func resourceAwsElbAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	elbName, instance, err := tfelb.AttachmentParseImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	//lintignore:R016 // Allow legacy unstable ID usage in managed resource
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", elbName)))
+	d.Set("elb", elbName)
+	d.Set("instance", instance)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsElbAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 	elbName := d.Get("elb").(string)
 
 	instance := d.Get("instance").(string)
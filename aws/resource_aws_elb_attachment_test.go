@@ -28,6 +28,12 @@ func TestAccAWSELBAttachment_basic(t *testing.T) {
 					testAccAWSELBAttachmentCheckInstanceCount(&conf, 1),
 				),
 			},
+			{
+				// The attachment ID is generated, so it differs after import.
+				ResourceName:      "aws_elb_attachment.foo1",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSELBAttachmentImportStateIdFunc("aws_elb_attachment.foo1"),
+			},
 			{
 				Config: testAccAWSELBAttachmentConfig2(),
 				Check: resource.ComposeTestCheckFunc(
@@ -59,7 +65,7 @@ func TestAccAWSELBAttachment_drift(t *testing.T) {
 	resourceName := "aws_elb.test"
 
 	deregInstance := func() {
-		conn := testAccProvider.Meta().(*AWSClient).elbconn
+		conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		deRegisterInstancesOpts := elb.DeregisterInstancesFromLoadBalancerInput{
 			LoadBalancerName: conf.LoadBalancerName,
@@ -260,3 +266,14 @@ resource "aws_elb" "test" {
 }
 `
 }
+
+func testAccAWSELBAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["elb"], rs.Primary.Attributes["instance"]), nil
+	}
+}
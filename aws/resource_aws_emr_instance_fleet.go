@@ -211,7 +211,7 @@ func resourceAwsEMRInstanceFleet() *schema.Resource {
 }
 
 func resourceAwsEMRInstanceFleetCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).emrconn()
 
 	addInstanceFleetInput := &emr.AddInstanceFleetInput{
 		ClusterId: aws.String(d.Get("cluster_id").(string)),
@@ -242,7 +242,7 @@ func resourceAwsEMRInstanceFleetCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsEMRInstanceFleetRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).emrconn()
 	instanceFleets, err := fetchAllEMRInstanceFleets(conn, d.Get("cluster_id").(string))
 	if err != nil {
 		log.Printf("[DEBUG] EMR doesn't have any Instance Fleet ")
@@ -282,7 +282,7 @@ func findInstanceFleetById(instanceFleets []*emr.InstanceFleet, fleetId string)
 }
 
 func resourceAwsEMRInstanceFleetUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).emrconn()
 
 	log.Printf("[DEBUG] Modify EMR task fleet")
 
@@ -343,7 +343,7 @@ func instanceFleetStateRefresh(conn *emr.EMR, clusterID, ifID string) resource.S
 
 func resourceAwsEMRInstanceFleetDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[WARN] AWS EMR Instance Fleet does not support DELETE; resizing cluster to zero before removing from state")
-	conn := meta.(*AWSClient).emrconn
+	conn := meta.(*AWSClient).emrconn()
 
 	clusterId := d.Get("cluster_id").(string)
 
@@ -28,7 +28,7 @@ func testSweepGlobalAcceleratorAccelerators(region string) error {
 	if err != nil {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
-	conn := client.(*AWSClient).globalacceleratorconn
+	conn := client.(*AWSClient).globalacceleratorconn()
 
 	input := &globalaccelerator.ListAcceleratorsInput{}
 	var sweeperErrs *multierror.Error
@@ -77,7 +77,7 @@ func testSweepGlobalAcceleratorAccelerators(region string) error {
 }
 
 func sweepGlobalAcceleratorListeners(client interface{}, acceleratorArn *string) *multierror.Error {
-	conn := client.(*AWSClient).globalacceleratorconn
+	conn := client.(*AWSClient).globalacceleratorconn()
 	var sweeperErrs *multierror.Error
 
 	log.Printf("[INFO] deleting Listeners for Accelerator %s", *acceleratorArn)
@@ -116,7 +116,7 @@ func sweepGlobalAcceleratorListeners(client interface{}, acceleratorArn *string)
 }
 
 func sweepGlobalAcceleratorEndpointGroups(client interface{}, listenerArn *string) *multierror.Error {
-	conn := client.(*AWSClient).globalacceleratorconn
+	conn := client.(*AWSClient).globalacceleratorconn()
 	var sweeperErrs *multierror.Error
 
 	log.Printf("[INFO] deleting Endpoint Groups for Listener %s", *listenerArn)
@@ -362,7 +362,7 @@ func TestAccAwsGlobalAcceleratorAccelerator_tags(t *testing.T) {
 }
 
 func testAccPreCheckGlobalAccelerator(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 	input := &globalaccelerator.ListAcceleratorsInput{}
 
@@ -379,7 +379,7 @@ func testAccPreCheckGlobalAccelerator(t *testing.T) {
 
 func testAccCheckGlobalAcceleratorAcceleratorExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+		conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -401,7 +401,7 @@ func testAccCheckGlobalAcceleratorAcceleratorExists(name string) resource.TestCh
 }
 
 func testAccCheckGlobalAcceleratorAcceleratorDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn
+	conn := testAccProvider.Meta().(*AWSClient).globalacceleratorconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_globalaccelerator_accelerator" {
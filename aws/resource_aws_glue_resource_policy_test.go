@@ -136,7 +136,7 @@ func testAccAWSGlueResourcePolicy(n string, action string) resource.TestCheckFun
 			return fmt.Errorf("No policy id set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).glueconn
+		conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 		policy, err := conn.GetResourcePolicy(&glue.GetResourcePolicyInput{})
 		if err != nil {
@@ -160,7 +160,7 @@ func testAccAWSGlueResourcePolicy(n string, action string) resource.TestCheckFun
 }
 
 func testAccCheckAWSGlueResourcePolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).glueconn
+	conn := testAccProvider.Meta().(*AWSClient).glueconn()
 
 	policy, err := conn.GetResourcePolicy(&glue.GetResourcePolicyInput{})
 
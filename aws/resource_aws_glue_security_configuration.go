@@ -117,7 +117,7 @@ func resourceAwsGlueSecurityConfiguration() *schema.Resource {
 }
 
 func resourceAwsGlueSecurityConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 	name := d.Get("name").(string)
 
 	input := &glue.CreateSecurityConfigurationInput{
@@ -137,7 +137,7 @@ func resourceAwsGlueSecurityConfigurationCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsGlueSecurityConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	input := &glue.GetSecurityConfigurationInput{
 		Name: aws.String(d.Id()),
@@ -173,7 +173,7 @@ func resourceAwsGlueSecurityConfigurationRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsGlueSecurityConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).glueconn
+	conn := meta.(*AWSClient).glueconn()
 
 	log.Printf("[DEBUG] Deleting Glue Security Configuration: %s", d.Id())
 	err := deleteGlueSecurityConfiguration(conn, d.Id())
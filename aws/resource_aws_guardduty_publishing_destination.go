@@ -50,7 +50,7 @@ func resourceAwsGuardDutyPublishingDestination() *schema.Resource {
 }
 
 func resourceAwsGuardDutyPublishingDestinationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	detectorID := d.Get("detector_id").(string)
 	input := guardduty.CreatePublishingDestinationInput{
@@ -81,7 +81,7 @@ func resourceAwsGuardDutyPublishingDestinationCreate(d *schema.ResourceData, met
 }
 
 func resourceAwsGuardDutyPublishingDestinationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	destinationId, detectorId, errStateRead := decodeGuardDutyPublishDestinationID(d.Id())
 
@@ -113,7 +113,7 @@ func resourceAwsGuardDutyPublishingDestinationRead(d *schema.ResourceData, meta
 }
 
 func resourceAwsGuardDutyPublishingDestinationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	destinationId, detectorId, errStateRead := decodeGuardDutyPublishDestinationID(d.Id())
 
@@ -140,7 +140,7 @@ func resourceAwsGuardDutyPublishingDestinationUpdate(d *schema.ResourceData, met
 }
 
 func resourceAwsGuardDutyPublishingDestinationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).guarddutyconn
+	conn := meta.(*AWSClient).guarddutyconn()
 
 	destinationId, detectorId, errStateRead := decodeGuardDutyPublishDestinationID(d.Id())
 
@@ -27,7 +27,7 @@ func testSweepGuarddutyPublishingDestinations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 
-	conn := client.(*AWSClient).guarddutyconn
+	conn := client.(*AWSClient).guarddutyconn()
 	var sweeperErrs *multierror.Error
 
 	detect_input := &guardduty.ListDetectorsInput{}
@@ -260,7 +260,7 @@ func testAccCheckAwsGuardDutyPublishingDestinationExists(name string) resource.T
 			DestinationId: aws.String(destination_id),
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 		_, err := conn.DescribePublishingDestination(input)
 		return err
 	}
@@ -268,7 +268,7 @@ func testAccCheckAwsGuardDutyPublishingDestinationExists(name string) resource.T
 
 func testAccCheckAwsGuardDutyPublishingDestinationDestroy(s *terraform.State) error {
 
-	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_guardduty_publishing_destination" {
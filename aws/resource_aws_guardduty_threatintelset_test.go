@@ -100,7 +100,7 @@ func testAccAwsGuardDutyThreatintelset_tags(t *testing.T) {
 }
 
 func testAccCheckAwsGuardDutyThreatintelsetDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_guardduty_threatintelset" {
@@ -151,7 +151,7 @@ func testAccCheckAwsGuardDutyThreatintelsetExists(name string) resource.TestChec
 			ThreatIntelSetId: aws.String(threatIntelSetId),
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn()
 		_, err = conn.GetThreatIntelSet(input)
 		return err
 	}
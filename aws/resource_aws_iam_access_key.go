@@ -27,7 +27,7 @@ func resourceAwsIamAccessKey() *schema.Resource {
 			//   ValidationError: Must specify userName when calling with non-User credentials
 			// To prevent import from requiring this extra information, use GetAccessKeyLastUsed.
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				conn := meta.(*AWSClient).iamconn
+				conn := meta.(*AWSClient).iamconn()
 
 				input := &iam.GetAccessKeyLastUsedInput{
 					AccessKeyId: aws.String(d.Id()),
@@ -96,7 +96,7 @@ func resourceAwsIamAccessKey() *schema.Resource {
 }
 
 func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.CreateAccessKeyInput{
 		UserName: aws.String(d.Get("user").(string)),
@@ -118,12 +118,7 @@ func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if v, ok := d.GetOk("pgp_key"); ok {
-		pgpKey := v.(string)
-		encryptionKey, err := encryption.RetrieveGPGKey(pgpKey)
-		if err != nil {
-			return err
-		}
-		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, *createResp.AccessKey.SecretAccessKey, "IAM Access Key Secret")
+		fingerprint, encrypted, err := encryption.Encrypt(v.(string), *createResp.AccessKey.SecretAccessKey, "IAM Access Key Secret", meta.(*AWSClient).kmsconn())
 		if err != nil {
 			return err
 		}
@@ -167,7 +162,7 @@ func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIamAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.ListAccessKeysInput{
 		UserName: aws.String(d.Get("user").(string)),
@@ -210,7 +205,7 @@ func resourceAwsIamAccessKeyReadResult(d *schema.ResourceData, key *iam.AccessKe
 }
 
 func resourceAwsIamAccessKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	if d.HasChange("status") {
 		if err := resourceAwsIamAccessKeyStatusUpdate(iamconn, d); err != nil {
@@ -222,7 +217,7 @@ func resourceAwsIamAccessKeyUpdate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIamAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.DeleteAccessKeyInput{
 		AccessKeyId: aws.String(d.Id()),
@@ -30,7 +30,7 @@ func resourceAwsIamAccountAlias() *schema.Resource {
 }
 
 func resourceAwsIamAccountAliasCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	account_alias := d.Get("account_alias").(string)
 
@@ -50,7 +50,7 @@ func resourceAwsIamAccountAliasCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsIamAccountAliasRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	params := &iam.ListAccountAliasesInput{}
 
@@ -74,7 +74,7 @@ func resourceAwsIamAccountAliasRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsIamAccountAliasDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	account_alias := d.Get("account_alias").(string)
 
@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfiam "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam"
 )
 
 func resourceAwsIamGroupMembership() *schema.Resource {
@@ -16,6 +17,10 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 		Update: resourceAwsIamGroupMembershipUpdate,
 		Delete: resourceAwsIamGroupMembershipDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupMembershipImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -40,7 +45,7 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 }
 
 func resourceAwsIamGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	group := d.Get("group").(string)
 	userList := expandStringSet(d.Get("users").(*schema.Set))
@@ -54,7 +59,7 @@ func resourceAwsIamGroupMembershipCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsIamGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	group := d.Get("group").(string)
 
 	var ul []string
@@ -94,8 +99,21 @@ func resourceAwsIamGroupMembershipRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceAwsIamGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name, group, err := tfiam.GroupMembershipParseImportID(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(name)
+	d.Set("name", name)
+	d.Set("group", group)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamGroupMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	if d.HasChange("users") {
 		group := d.Get("group").(string)
@@ -126,7 +144,7 @@ func resourceAwsIamGroupMembershipUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsIamGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	userList := expandStringSet(d.Get("users").(*schema.Set))
 	group := d.Get("group").(string)
 
@@ -36,6 +36,12 @@ func TestAccAWSGroupMembership_basic(t *testing.T) {
 					testAccCheckAWSGroupMembershipAttributes(&group, groupName, []string{userName}),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_group_membership.team",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", membershipName, groupName),
+				ImportStateVerify: true,
+			},
 
 			{
 				Config: testAccAWSGroupMemberConfigUpdate(groupName, userName, userName2, userName3, membershipName),
@@ -83,7 +89,7 @@ func TestAccAWSGroupMembership_paginatedUserList(t *testing.T) {
 }
 
 func testAccCheckAWSGroupMembershipDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iamconn
+	conn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_group_membership" {
@@ -120,7 +126,7 @@ func testAccCheckAWSGroupMembershipExists(n string, g *iam.GetGroupOutput) resou
 			return fmt.Errorf("No User name is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		conn := testAccProvider.Meta().(*AWSClient).iamconn()
 		gn := rs.Primary.Attributes["group"]
 
 		resp, err := conn.GetGroup(&iam.GetGroupInput{
@@ -55,7 +55,7 @@ func resourceAwsIamGroupPolicy() *schema.Resource {
 }
 
 func resourceAwsIamGroupPolicyPut(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	request := &iam.PutGroupPolicyInput{
 		GroupName:      aws.String(d.Get("group").(string)),
@@ -81,7 +81,7 @@ func resourceAwsIamGroupPolicyPut(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceAwsIamGroupPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	group, name, err := resourceAwsIamGroupPolicyParseId(d.Id())
 	if err != nil {
@@ -128,7 +128,7 @@ func resourceAwsIamGroupPolicyRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsIamGroupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 
 	group, name, err := resourceAwsIamGroupPolicyParseId(d.Id())
 	if err != nil {
@@ -40,6 +40,12 @@ func TestAccAWSIAMPolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSPolicyAttachmentAttributes([]string{userName}, []string{roleName}, []string{groupName}, &out),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSPolicyAttachmentImportStateIdFunc("aws_iam_policy_attachment.test-attach"),
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSPolicyAttachConfigUpdate(userName, userName2, userName3,
 					roleName, roleName2, roleName3,
@@ -190,7 +196,7 @@ func testAccCheckAWSPolicyAttachmentExists(n string, c int64, out *iam.ListEntit
 			return fmt.Errorf("No policy name is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iamconn
+		conn := testAccProvider.Meta().(*AWSClient).iamconn()
 		arn := rs.Primary.Attributes["policy_arn"]
 
 		resp, err := conn.GetPolicy(&iam.GetPolicyInput{
@@ -692,3 +698,14 @@ resource "aws_iam_policy_attachment" "test" {
 }
 `, rName, userName)
 }
+
+func testAccAWSPolicyAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["name"], rs.Primary.Attributes["policy_arn"]), nil
+	}
+}
@@ -33,7 +33,7 @@ func testSweepIamPolicies(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).iamconn
+	conn := client.(*AWSClient).iamconn()
 	input := &iam.ListPoliciesInput{
 		Scope: aws.String(iam.PolicyScopeTypeLocal),
 	}
@@ -282,6 +282,48 @@ func TestAccAWSIAMPolicy_policy(t *testing.T) {
 	})
 }
 
+func TestAccAWSIAMPolicy_policyValidation(t *testing.T) {
+	var out iam.GetPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_policy.test"
+	policy1 := "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Action\":[\"ec2:*\"],\"Effect\":\"Allow\",\"Resource\":\"*\"}]}"
+	policy2 := "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Action\":[\"ec2:Describe*\"],\"Effect\":\"Allow\",\"Resource\":\"*\"}]}"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, iam.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIAMPolicyConfigPolicyValidation(rName, policy1, "error"),
+				ExpectError: regexp.MustCompile(`write actions \(ec2:\*\) allowed on Resource "\*"`),
+			},
+			{
+				Config: testAccAWSIAMPolicyConfigPolicyValidation(rName, policy1, "warn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMPolicyExists(resourceName, &out),
+					resource.TestCheckResourceAttr(resourceName, "policy_validation", "warn"),
+				),
+			},
+			{
+				Config: testAccAWSIAMPolicyConfigPolicyValidation(rName, policy2, "error"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMPolicyExists(resourceName, &out),
+					resource.TestCheckResourceAttr(resourceName, "policy", policy2),
+					resource.TestCheckResourceAttr(resourceName, "policy_validation", "error"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_validation"},
+			},
+		},
+	})
+}
+
 func testAccCheckAWSIAMPolicyExists(resource string, res *iam.GetPolicyOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resource]
@@ -293,7 +335,7 @@ func testAccCheckAWSIAMPolicyExists(resource string, res *iam.GetPolicyOutput) r
 			return fmt.Errorf("No Policy name is set")
 		}
 
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		resp, err := iamconn.GetPolicy(&iam.GetPolicyInput{
 			PolicyArn: aws.String(rs.Primary.Attributes["arn"]),
@@ -309,7 +351,7 @@ func testAccCheckAWSIAMPolicyExists(resource string, res *iam.GetPolicyOutput) r
 }
 
 func testAccCheckAWSIAMPolicyDestroy(s *terraform.State) error {
-	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+	iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iam_policy" {
@@ -336,7 +378,7 @@ func testAccCheckAWSIAMPolicyDestroy(s *terraform.State) error {
 
 func testAccCheckAWSIAMPolicyDisappears(out *iam.GetPolicyOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		iamconn := testAccProvider.Meta().(*AWSClient).iamconn
+		iamconn := testAccProvider.Meta().(*AWSClient).iamconn()
 
 		params := &iam.DeletePolicyInput{
 			PolicyArn: out.Policy.Arn,
@@ -441,6 +483,16 @@ EOF
 `, rName, path)
 }
 
+func testAccAWSIAMPolicyConfigPolicyValidation(rName, policy, policyValidation string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name              = %[1]q
+  policy            = %[2]q
+  policy_validation = %[3]q
+}
+`, rName, policy, policyValidation)
+}
+
 func testAccAWSIAMPolicyConfigPolicy(rName, policy string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_policy" "test" {
@@ -79,7 +79,7 @@ func resourceAwsIamServiceLinkedRole() *schema.Resource {
 }
 
 func resourceAwsIamServiceLinkedRoleCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	serviceName := d.Get("aws_service_name").(string)
 
@@ -106,7 +106,7 @@ func resourceAwsIamServiceLinkedRoleCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsIamServiceLinkedRoleRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	serviceName, roleName, customSuffix, err := decodeIamServiceLinkedRoleID(d.Id())
 	if err != nil {
@@ -143,7 +143,7 @@ func resourceAwsIamServiceLinkedRoleRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsIamServiceLinkedRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	_, roleName, _, err := decodeIamServiceLinkedRoleID(d.Id())
 	if err != nil {
@@ -165,7 +165,7 @@ func resourceAwsIamServiceLinkedRoleUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsIamServiceLinkedRoleDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	_, roleName, _, err := decodeIamServiceLinkedRoleID(d.Id())
 	if err != nil {
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"log"
 	"math/big"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -123,12 +122,12 @@ func checkIAMPwdPolicy(pass []byte) bool {
 }
 
 func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface{}) error {
-	iamconn := meta.(*AWSClient).iamconn
+	iamconn := meta.(*AWSClient).iamconn()
 	username := d.Get("user").(string)
 
-	encryptionKey, err := encryption.RetrieveGPGKey(strings.TrimSpace(d.Get("pgp_key").(string)))
+	encrypter, err := encryption.NewEncrypter(d.Get("pgp_key").(string), meta.(*AWSClient).kmsconn())
 	if err != nil {
-		return fmt.Errorf("error retrieving GPG Key during IAM User Login Profile (%s) creation: %s", username, err)
+		return fmt.Errorf("error retrieving encryption key during IAM User Login Profile (%s) creation: %s", username, err)
 	}
 
 	passwordResetRequired := d.Get("password_reset_required").(bool)
@@ -138,7 +137,7 @@ func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, initialPassword, "Password")
+	fingerprint, encrypted, err := encrypter.Encrypt([]byte(initialPassword))
 	if err != nil {
 		return fmt.Errorf("error encrypting password during IAM User Login Profile (%s) creation: %s", username, err)
 	}
@@ -162,7 +161,7 @@ func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsIamUserLoginProfileRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	input := &iam.GetLoginProfileInput{
 		UserName: aws.String(d.Id()),
@@ -191,7 +190,7 @@ func resourceAwsIamUserLoginProfileRead(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsIamUserLoginProfileDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	input := &iam.DeleteLoginProfileInput{
 		UserName: aws.String(d.Id()),
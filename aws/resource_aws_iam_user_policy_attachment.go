@@ -37,7 +37,7 @@ func resourceAwsIamUserPolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsIamUserPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 
 	user := d.Get("user").(string)
 	arn := d.Get("policy_arn").(string)
@@ -54,7 +54,7 @@ func resourceAwsIamUserPolicyAttachmentCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsIamUserPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	user := d.Get("user").(string)
 	arn := d.Get("policy_arn").(string)
 
@@ -95,7 +95,7 @@ func resourceAwsIamUserPolicyAttachmentRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsIamUserPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).iamconn
+	conn := meta.(*AWSClient).iamconn()
 	user := d.Get("user").(string)
 	arn := d.Get("policy_arn").(string)
 
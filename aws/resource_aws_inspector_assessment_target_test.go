@@ -149,7 +149,7 @@ func TestAccAWSInspectorTarget_ResourceGroupArn(t *testing.T) {
 }
 
 func testAccCheckAWSInspectorTargetAssessmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).inspectorconn
+	conn := testAccProvider.Meta().(*AWSClient).inspectorconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_inspector_assessment_target" {
@@ -177,7 +177,7 @@ func testAccCheckAWSInspectorTargetExists(name string, target *inspector.Assessm
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).inspectorconn
+		conn := testAccProvider.Meta().(*AWSClient).inspectorconn()
 
 		assessmentTarget, err := describeInspectorAssessmentTarget(conn, rs.Primary.ID)
 
@@ -197,7 +197,7 @@ func testAccCheckAWSInspectorTargetExists(name string, target *inspector.Assessm
 
 func testAccCheckAWSInspectorTargetDisappears(assessmentTarget *inspector.AssessmentTarget) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).inspectorconn
+		conn := testAccProvider.Meta().(*AWSClient).inspectorconn()
 
 		input := &inspector.DeleteAssessmentTargetInput{
 			AssessmentTargetArn: assessmentTarget.Arn,
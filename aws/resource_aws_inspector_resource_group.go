@@ -31,7 +31,7 @@ func resourceAWSInspectorResourceGroup() *schema.Resource {
 }
 
 func resourceAwsInspectorResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	req := &inspector.CreateResourceGroupInput{
 		ResourceGroupTags: expandInspectorResourceGroupTags(d.Get("tags").(map[string]interface{})),
@@ -49,7 +49,7 @@ func resourceAwsInspectorResourceGroupCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsInspectorResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).inspectorconn
+	conn := meta.(*AWSClient).inspectorconn()
 
 	resp, err := conn.DescribeResourceGroups(&inspector.DescribeResourceGroupsInput{
 		ResourceGroupArns: aws.StringSlice([]string{d.Id()}),
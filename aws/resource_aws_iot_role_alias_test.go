@@ -76,7 +76,7 @@ func TestAccAWSIotRoleAlias_basic(t *testing.T) {
 }
 
 func testAccCheckAWSIotRoleAliasDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).iotconn
+	conn := testAccProvider.Meta().(*AWSClient).iotconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_iot_role_alias" {
 			continue
@@ -104,7 +104,7 @@ func testAccCheckAWSIotRoleAliasExists(n string) resource.TestCheckFunc {
 			return fmt.Errorf("No ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).iotconn
+		conn := testAccProvider.Meta().(*AWSClient).iotconn()
 		role_arn := rs.Primary.Attributes["role_arn"]
 
 		roleAliasDescription, err := getIotRoleAliasDescription(conn, rs.Primary.ID)
@@ -51,7 +51,7 @@ func resourceAwsKinesisStreamConsumer() *schema.Resource {
 }
 
 func resourceAwsKinesisStreamConsumerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).kinesisconn()
 
 	name := d.Get("name").(string)
 	streamArn := d.Get("stream_arn").(string)
@@ -80,7 +80,7 @@ func resourceAwsKinesisStreamConsumerCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsKinesisStreamConsumerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).kinesisconn()
 
 	consumer, err := finder.StreamConsumerByARN(conn, d.Id())
 
@@ -112,7 +112,7 @@ func resourceAwsKinesisStreamConsumerRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsKinesisStreamConsumerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kinesisconn
+	conn := meta.(*AWSClient).kinesisconn()
 
 	input := &kinesis.DeregisterStreamConsumerInput{
 		ConsumerARN: aws.String(d.Id()),
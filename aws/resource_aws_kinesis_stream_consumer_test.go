@@ -121,7 +121,7 @@ func TestAccAWSKinesisStreamConsumer_ExceedMaxConcurrentConsumers(t *testing.T)
 }
 
 func testAccCheckAWSKinesisStreamConsumerDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+	conn := testAccProvider.Meta().(*AWSClient).kinesisconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kinesis_stream_consumer" {
@@ -158,7 +158,7 @@ func testAccAWSKinesisStreamConsumerExists(resourceName string) resource.TestChe
 			return fmt.Errorf("resource %s has not set its id", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn()
 
 		consumer, err := finder.StreamConsumerByARN(conn, rs.Primary.ID)
 
@@ -160,7 +160,7 @@ func TestAccAWSKmsAlias_ArnDiffSuppress(t *testing.T) {
 }
 
 func testAccCheckAWSKmsAliasDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_kms_alias" {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+	tflakeformation "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/lakeformation"
 )
 
 func resourceAwsLakeFormationPermissions() *schema.Resource {
@@ -22,6 +23,10 @@ func resourceAwsLakeFormationPermissions() *schema.Resource {
 		Update: resourceAwsLakeFormationPermissionsCreate,
 		Delete: resourceAwsLakeFormationPermissionsDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLakeFormationPermissionsImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"catalog_id": {
 				Type:         schema.TypeString,
@@ -180,7 +185,7 @@ func resourceAwsLakeFormationPermissions() *schema.Resource {
 }
 
 func resourceAwsLakeFormationPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lakeformationconn
+	conn := meta.(*AWSClient).lakeformationconn()
 
 	input := &lakeformation.GrantPermissionsInput{
 		Permissions: expandStringList(d.Get("permissions").([]interface{})),
@@ -243,7 +248,7 @@ func resourceAwsLakeFormationPermissionsCreate(d *schema.ResourceData, meta inte
 }
 
 func resourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lakeformationconn
+	conn := meta.(*AWSClient).lakeformationconn()
 
 	input := &lakeformation.ListPermissionsInput{
 		Principal: &lakeformation.DataLakePrincipal{
@@ -364,7 +369,7 @@ func resourceAwsLakeFormationPermissionsRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsLakeFormationPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lakeformationconn
+	conn := meta.(*AWSClient).lakeformationconn()
 
 	input := &lakeformation.RevokePermissionsInput{
 		Permissions: expandStringList(d.Get("permissions").([]interface{})),
@@ -410,6 +415,39 @@ func resourceAwsLakeFormationPermissionsDelete(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceAwsLakeFormationPermissionsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	principal, resourceType, names, err := tflakeformation.PermissionsParseImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("principal", principal)
+
+	switch resourceType {
+	case lakeformation.DataLakeResourceTypeCatalog:
+		d.Set("catalog_resource", true)
+	case lakeformation.DataLakeResourceTypeDataLocation:
+		d.Set("data_location", []interface{}{map[string]interface{}{"arn": names[0]}})
+	case lakeformation.DataLakeResourceTypeDatabase:
+		d.Set("database", []interface{}{map[string]interface{}{"name": names[0]}})
+	case lakeformation.DataLakeResourceTypeTable:
+		table := map[string]interface{}{"database_name": names[0]}
+
+		if names[1] == tflakeformation.TableWildcard {
+			table["wildcard"] = true
+		} else {
+			table["name"] = names[1]
+		}
+
+		d.Set("table", []interface{}{table})
+	}
+
+	// The resource ID is not derived from the permissions, so any stable value will do.
+	d.SetId(fmt.Sprintf("%d", hashcode.String(d.Id())))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLakeFormationPermissionsCompareResource(in, out lakeformation.Resource) bool {
 	if in.DataLocation != nil && out.DataLocation != nil && in.DataLocation.CatalogId == nil {
 		in.DataLocation.CatalogId = out.DataLocation.CatalogId
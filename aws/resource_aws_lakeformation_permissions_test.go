@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -94,6 +95,12 @@ func testAccAWSLakeFormationPermissions_database(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "permissions_with_grant_option.0", lakeformation.PermissionCreateTable),
 				),
 			},
+			{
+				// The permissions ID is not derived from the permissions, so it differs after import.
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSLakeFormationPermissionsImportStateIdFunc(resourceName, lakeformation.DataLakeResourceTypeDatabase, "database.0.name"),
+			},
 		},
 	})
 }
@@ -124,6 +131,12 @@ func testAccAWSLakeFormationPermissions_table_name(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "permissions.2", lakeformation.PermissionDescribe),
 				),
 			},
+			{
+				// The permissions ID is not derived from the permissions, so it differs after import.
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSLakeFormationPermissionsImportStateIdFunc(resourceName, lakeformation.DataLakeResourceTypeTable, "table.0.database_name", "table.0.name"),
+			},
 		},
 	})
 }
@@ -238,8 +251,24 @@ func testAccAWSLakeFormationPermissions_selectPermissions(t *testing.T) {
 	})
 }
 
+func testAccAWSLakeFormationPermissionsImportStateIdFunc(resourceName, resourceType string, nameAttributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		parts := []string{rs.Primary.Attributes["principal"], resourceType}
+		for _, attribute := range nameAttributes {
+			parts = append(parts, rs.Primary.Attributes[attribute])
+		}
+
+		return strings.Join(parts, ","), nil
+	}
+}
+
 func testAccCheckAWSLakeFormationPermissionsDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+	conn := testAccProvider.Meta().(*AWSClient).lakeformationconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lakeformation_permissions" {
@@ -273,7 +302,7 @@ func testAccCheckAWSLakeFormationPermissionsExists(resourceName string) resource
 			return fmt.Errorf("resource not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn
+		conn := testAccProvider.Meta().(*AWSClient).lakeformationconn()
 
 		input := &lakeformation.ListPermissionsInput{
 			MaxResults: aws.Int64(1),
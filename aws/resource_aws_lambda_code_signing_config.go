@@ -82,7 +82,7 @@ func resourceAwsLambdaCodeSigningConfig() *schema.Resource {
 }
 
 func resourceAwsLambdaCodeSigningConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	log.Printf("[DEBUG] Creating Lambda code signing config")
 
@@ -113,7 +113,7 @@ func resourceAwsLambdaCodeSigningConfigCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsLambdaCodeSigningConfigRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	configOutput, err := conn.GetCodeSigningConfig(&lambda.GetCodeSigningConfigInput{
 		CodeSigningConfigArn: aws.String(d.Id()),
@@ -162,7 +162,7 @@ func resourceAwsLambdaCodeSigningConfigRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsLambdaCodeSigningConfigUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	configInput := &lambda.UpdateCodeSigningConfigInput{
 		CodeSigningConfigArn: aws.String(d.Id()),
@@ -199,7 +199,7 @@ func resourceAwsLambdaCodeSigningConfigUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsLambdaCodeSigningConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lambdaconn
+	conn := meta.(*AWSClient).lambdaconn()
 
 	_, err := conn.DeleteCodeSigningConfig(&lambda.DeleteCodeSigningConfigInput{
 		CodeSigningConfigArn: aws.String(d.Id()),
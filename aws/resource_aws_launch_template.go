@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/experimental/nullable"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
@@ -104,20 +105,20 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 										// since TypeBool only has true/false with false default.
 										// The conversion from bare true/false values in
 										// configurations to TypeString value is currently safe.
-										Type:             schema.TypeString,
+										Type:             nullable.TypeNullableBool,
 										Optional:         true,
-										DiffSuppressFunc: suppressEquivalentTypeStringBoolean,
-										ValidateFunc:     validateTypeStringNullableBoolean,
+										DiffSuppressFunc: nullable.DiffSuppressTypeStringNullableBool,
+										ValidateFunc:     nullable.ValidateTypeStringNullableBool,
 									},
 									"encrypted": {
 										// Use TypeString to allow an "unspecified" value,
 										// since TypeBool only has true/false with false default.
 										// The conversion from bare true/false values in
 										// configurations to TypeString value is currently safe.
-										Type:             schema.TypeString,
+										Type:             nullable.TypeNullableBool,
 										Optional:         true,
-										DiffSuppressFunc: suppressEquivalentTypeStringBoolean,
-										ValidateFunc:     validateTypeStringNullableBoolean,
+										DiffSuppressFunc: nullable.DiffSuppressTypeStringNullableBool,
+										ValidateFunc:     nullable.ValidateTypeStringNullableBool,
 									},
 									"iops": {
 										Type:     schema.TypeInt,
@@ -230,10 +231,10 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 				// since TypeBool only has true/false with false default.
 				// The conversion from bare true/false values in
 				// configurations to TypeString value is currently safe.
-				Type:             schema.TypeString,
+				Type:             nullable.TypeNullableBool,
 				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentTypeStringBoolean,
-				ValidateFunc:     validateTypeStringNullableBoolean,
+				DiffSuppressFunc: nullable.DiffSuppressTypeStringNullableBool,
+				ValidateFunc:     nullable.ValidateTypeStringNullableBool,
 			},
 
 			"elastic_gpu_specifications": {
@@ -439,26 +440,26 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"associate_carrier_ip_address": {
-							Type:             schema.TypeString,
+							Type:             nullable.TypeNullableBool,
 							Optional:         true,
-							DiffSuppressFunc: suppressEquivalentTypeStringBoolean,
-							ValidateFunc:     validateTypeStringNullableBoolean,
+							DiffSuppressFunc: nullable.DiffSuppressTypeStringNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
 						},
 						"associate_public_ip_address": {
-							Type:             schema.TypeString,
+							Type:             nullable.TypeNullableBool,
 							Optional:         true,
-							DiffSuppressFunc: suppressEquivalentTypeStringBoolean,
-							ValidateFunc:     validateTypeStringNullableBoolean,
+							DiffSuppressFunc: nullable.DiffSuppressTypeStringNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
 						},
 						"delete_on_termination": {
 							// Use TypeString to allow an "unspecified" value,
 							// since TypeBool only has true/false with false default.
 							// The conversion from bare true/false values in
 							// configurations to TypeString value is currently safe.
-							Type:             schema.TypeString,
+							Type:             nullable.TypeNullableBool,
 							Optional:         true,
-							DiffSuppressFunc: suppressEquivalentTypeStringBoolean,
-							ValidateFunc:     validateTypeStringNullableBoolean,
+							DiffSuppressFunc: nullable.DiffSuppressTypeStringNullableBool,
+							ValidateFunc:     nullable.ValidateTypeStringNullableBool,
 						},
 						"description": {
 							Type:     schema.TypeString,
@@ -601,7 +602,8 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 				Optional: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 			"hibernation_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -642,12 +644,16 @@ func resourceAwsLaunchTemplate() *schema.Resource {
 				}
 				return false
 			}),
+			SetTagsDiff,
 		),
 	}
 }
 
 func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
+
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	var ltName string
 	if v, ok := d.GetOk("name"); ok {
@@ -667,7 +673,7 @@ func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) e
 		ClientToken:        aws.String(resource.UniqueId()),
 		LaunchTemplateName: aws.String(ltName),
 		LaunchTemplateData: launchTemplateData,
-		TagSpecifications:  ec2TagSpecificationsFromMap(d.Get("tags").(map[string]interface{}), ec2.ResourceTypeLaunchTemplate),
+		TagSpecifications:  ec2TagSpecificationsFromKeyValueTags(tags, ec2.ResourceTypeLaunchTemplate),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -689,7 +695,8 @@ func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	log.Printf("[DEBUG] Reading launch template %s", d.Id())
@@ -731,10 +738,17 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("name", lt.LaunchTemplateName)
 	d.Set("latest_version", lt.LatestVersionNumber)
 	d.Set("default_version", lt.DefaultVersionNumber)
-	if err := d.Set("tags", keyvaluetags.Ec2KeyValueTags(lt.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := keyvaluetags.Ec2KeyValueTags(lt.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	arn := arn.ARN{
 		Partition: meta.(*AWSClient).partition,
 		Service:   ec2.ServiceName,
@@ -772,7 +786,7 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("ebs_optimized", "")
 
 	if ltData.EbsOptimized != nil {
-		d.Set("ebs_optimized", strconv.FormatBool(aws.BoolValue(ltData.EbsOptimized)))
+		d.Set("ebs_optimized", string(nullable.NewBool(ltData.EbsOptimized)))
 	}
 
 	if err := d.Set("block_device_mappings", getBlockDeviceMappings(ltData.BlockDeviceMappings)); err != nil {
@@ -845,7 +859,7 @@ func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	latestVersion := int64(d.Get("latest_version").(int))
 	defaultVersion := d.Get("default_version").(int)
@@ -895,8 +909,8 @@ func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.Ec2UpdateTags(conn, d.Id(), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
@@ -907,7 +921,7 @@ func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	log.Printf("[DEBUG] Launch Template destroy: %v", d.Id())
 	_, err := conn.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{
@@ -945,10 +959,10 @@ func getBlockDeviceMappings(m []*ec2.LaunchTemplateBlockDeviceMapping) []interfa
 				"volume_type": aws.StringValue(v.Ebs.VolumeType),
 			}
 			if v.Ebs.DeleteOnTermination != nil {
-				ebs["delete_on_termination"] = strconv.FormatBool(aws.BoolValue(v.Ebs.DeleteOnTermination))
+				ebs["delete_on_termination"] = string(nullable.NewBool(v.Ebs.DeleteOnTermination))
 			}
 			if v.Ebs.Encrypted != nil {
-				ebs["encrypted"] = strconv.FormatBool(aws.BoolValue(v.Ebs.Encrypted))
+				ebs["encrypted"] = string(nullable.NewBool(v.Ebs.Encrypted))
 			}
 			if v.Ebs.Iops != nil {
 				ebs["iops"] = aws.Int64Value(v.Ebs.Iops)
@@ -1176,15 +1190,15 @@ func getNetworkInterfaces(n []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecifi
 		}
 
 		if v.AssociateCarrierIpAddress != nil {
-			networkInterface["associate_carrier_ip_address"] = strconv.FormatBool(aws.BoolValue(v.AssociateCarrierIpAddress))
+			networkInterface["associate_carrier_ip_address"] = string(nullable.NewBool(v.AssociateCarrierIpAddress))
 		}
 
 		if v.AssociatePublicIpAddress != nil {
-			networkInterface["associate_public_ip_address"] = strconv.FormatBool(aws.BoolValue(v.AssociatePublicIpAddress))
+			networkInterface["associate_public_ip_address"] = string(nullable.NewBool(v.AssociatePublicIpAddress))
 		}
 
 		if v.DeleteOnTermination != nil {
-			networkInterface["delete_on_termination"] = strconv.FormatBool(aws.BoolValue(v.DeleteOnTermination))
+			networkInterface["delete_on_termination"] = string(nullable.NewBool(v.DeleteOnTermination))
 		}
 
 		if len(v.Ipv6Addresses) > 0 {
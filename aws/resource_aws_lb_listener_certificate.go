@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	tfelbv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elbv2"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elbv2/lister"
 )
 
 func resourceAwsLbListenerCertificate() *schema.Resource {
@@ -39,7 +40,7 @@ func resourceAwsLbListenerCertificate() *schema.Resource {
 }
 
 func resourceAwsLbListenerCertificateCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elbv2conn
+	conn := meta.(*AWSClient).elbv2conn()
 
 	listenerArn := d.Get("listener_arn").(string)
 	certificateArn := d.Get("certificate_arn").(string)
@@ -84,7 +85,7 @@ func resourceAwsLbListenerCertificateCreate(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsLbListenerCertificateRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elbv2conn
+	conn := meta.(*AWSClient).elbv2conn()
 
 	listenerArn, certificateArn, err := tfelbv2.ListenerCertificateParseID(d.Id())
 	if err != nil {
@@ -96,7 +97,7 @@ func resourceAwsLbListenerCertificateRead(d *schema.ResourceData, meta interface
 	var certificate *elbv2.Certificate
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		var err error
-		certificate, err = findAwsLbListenerCertificate(certificateArn, listenerArn, true, nil, conn)
+		certificate, err = findAwsLbListenerCertificate(certificateArn, listenerArn, true, conn)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -112,7 +113,7 @@ func resourceAwsLbListenerCertificateRead(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if isResourceTimeoutError(err) {
-		certificate, err = findAwsLbListenerCertificate(certificateArn, listenerArn, true, nil, conn)
+		certificate, err = findAwsLbListenerCertificate(certificateArn, listenerArn, true, conn)
 	}
 	if err != nil {
 		if certificate == nil {
@@ -130,7 +131,7 @@ func resourceAwsLbListenerCertificateRead(d *schema.ResourceData, meta interface
 }
 
 func resourceAwsLbListenerCertificateDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).elbv2conn
+	conn := meta.(*AWSClient).elbv2conn()
 
 	certificateArn := d.Get("certificate_arn").(string)
 	listenerArn := d.Get("listener_arn").(string)
@@ -160,32 +161,31 @@ func resourceAwsLbListenerCertificateDelete(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func findAwsLbListenerCertificate(certificateArn, listenerArn string, skipDefault bool, nextMarker *string, conn *elbv2.ELBV2) (*elbv2.Certificate, error) {
-	params := &elbv2.DescribeListenerCertificatesInput{
+func findAwsLbListenerCertificate(certificateArn, listenerArn string, skipDefault bool, conn *elbv2.ELBV2) (*elbv2.Certificate, error) {
+	input := &elbv2.DescribeListenerCertificatesInput{
 		ListenerArn: aws.String(listenerArn),
-		PageSize:    aws.Int64(400),
-	}
-	if nextMarker != nil {
-		params.Marker = nextMarker
 	}
 
-	resp, err := conn.DescribeListenerCertificates(params)
-	if err != nil {
-		return nil, err
-	}
+	var result *elbv2.Certificate
 
-	for _, cert := range resp.Certificates {
-		if skipDefault && aws.BoolValue(cert.IsDefault) {
-			continue
-		}
+	err := lister.DescribeListenerCertificatesPages(conn, input, func(page *elbv2.DescribeListenerCertificatesOutput, lastPage bool) bool {
+		for _, cert := range page.Certificates {
+			if skipDefault && aws.BoolValue(cert.IsDefault) {
+				continue
+			}
 
-		if aws.StringValue(cert.CertificateArn) == certificateArn {
-			return cert, nil
+			if aws.StringValue(cert.CertificateArn) == certificateArn {
+				result = cert
+				return false
+			}
 		}
-	}
 
-	if resp.NextMarker != nil {
-		return findAwsLbListenerCertificate(certificateArn, listenerArn, skipDefault, resp.NextMarker, conn)
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
 	}
-	return nil, nil
+
+	return result, nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elbv2/lister"
 )
 
 func resourceAwsLbbListenerRule() *schema.Resource {
@@ -474,7 +475,7 @@ func suppressIfActionTypeNot(t string) schema.SchemaDiffSuppressFunc {
 }
 
 func resourceAwsLbListenerRuleCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 	listenerArn := d.Get("listener_arn").(string)
 
 	params := &elbv2.CreateRuleInput{
@@ -664,7 +665,7 @@ func resourceAwsLbListenerRuleCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLbListenerRuleRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	var resp *elbv2.DescribeRulesOutput
 	var req = &elbv2.DescribeRulesInput{
@@ -886,7 +887,7 @@ func resourceAwsLbListenerRuleRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsLbListenerRuleUpdate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	if d.HasChange("priority") {
 		params := &elbv2.SetRulePrioritiesInput{
@@ -1064,7 +1065,7 @@ func resourceAwsLbListenerRuleUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLbListenerRuleDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	_, err := elbconn.DeleteRule(&elbv2.DeleteRuleInput{
 		RuleArn: aws.String(d.Id()),
@@ -1101,26 +1102,24 @@ func lbListenerARNFromRuleARN(ruleArn string) string {
 
 func highestListenerRulePriority(conn *elbv2.ELBV2, arn string) (priority int64, err error) {
 	var priorities []int
-	var nextMarker *string
 
-	for {
-		out, aerr := conn.DescribeRules(&elbv2.DescribeRulesInput{
-			ListenerArn: aws.String(arn),
-			Marker:      nextMarker,
-		})
-		if aerr != nil {
-			return 0, aerr
-		}
-		for _, rule := range out.Rules {
+	input := &elbv2.DescribeRulesInput{
+		ListenerArn: aws.String(arn),
+	}
+
+	err = lister.DescribeRulesPages(conn, input, func(page *elbv2.DescribeRulesOutput, lastPage bool) bool {
+		for _, rule := range page.Rules {
 			if aws.StringValue(rule.Priority) != "default" {
 				p, _ := strconv.Atoi(aws.StringValue(rule.Priority))
 				priorities = append(priorities, p)
 			}
 		}
-		if out.NextMarker == nil {
-			break
-		}
-		nextMarker = out.NextMarker
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return 0, err
 	}
 
 	if len(priorities) == 0 {
@@ -21,6 +21,9 @@ func resourceAwsLBSSLNegotiationPolicy() *schema.Resource {
 		Create: resourceAwsLBSSLNegotiationPolicyCreate,
 		Read:   resourceAwsLBSSLNegotiationPolicyRead,
 		Delete: resourceAwsLBSSLNegotiationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -70,7 +73,7 @@ func resourceAwsLBSSLNegotiationPolicy() *schema.Resource {
 }
 
 func resourceAwsLBSSLNegotiationPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	// Provision the SSLNegotiationPolicy
 	lbspOpts := &elb.CreateLoadBalancerPolicyInput{
@@ -109,7 +112,7 @@ func resourceAwsLBSSLNegotiationPolicyCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsLBSSLNegotiationPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	lbName, lbPort, policyName, err := resourceAwsLBSSLNegotiationPolicyParseId(d.Id())
 	if err != nil {
@@ -162,7 +165,7 @@ func resourceAwsLBSSLNegotiationPolicyRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsLBSSLNegotiationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	lbName, _, policyName, err := resourceAwsLBSSLNegotiationPolicyParseId(d.Id())
 	if err != nil {
@@ -33,6 +33,13 @@ func TestAccAWSLBSSLNegotiationPolicy_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "attribute.#", "7"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The policy attributes are not read back from the API.
+				ImportStateVerifyIgnore: []string{"attribute"},
+			},
 		},
 	})
 }
@@ -66,7 +73,7 @@ func TestAccAWSLBSSLNegotiationPolicy_disappears(t *testing.T) {
 }
 
 func testAccCheckLBSSLNegotiationPolicyDestroy(s *terraform.State) error {
-	elbconn := testAccProvider.Meta().(*AWSClient).elbconn
+	elbconn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_elb" && rs.Type != "aws_lb_ssl_negotiation_policy" {
@@ -132,7 +139,7 @@ func testAccCheckLBSSLNegotiationPolicy(elbResource string, policyResource strin
 			return fmt.Errorf("Not found: %s", policyResource)
 		}
 
-		elbconn := testAccProvider.Meta().(*AWSClient).elbconn
+		elbconn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		elbName, _, policyName, err := resourceAwsLBSSLNegotiationPolicyParseId(policy.Primary.ID)
 		if err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfelbv2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elbv2"
 )
 
 func resourceAwsLbTargetGroupAttachment() *schema.Resource {
@@ -17,6 +18,10 @@ func resourceAwsLbTargetGroupAttachment() *schema.Resource {
 		Read:   resourceAwsLbAttachmentRead,
 		Delete: resourceAwsLbAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLbAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"target_group_arn": {
 				Type:     schema.TypeString,
@@ -46,7 +51,7 @@ func resourceAwsLbTargetGroupAttachment() *schema.Resource {
 }
 
 func resourceAwsLbAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	target := &elbv2.TargetDescription{
 		Id: aws.String(d.Get("target_id").(string)),
@@ -95,7 +100,7 @@ func resourceAwsLbAttachmentCreate(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsLbAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	target := &elbv2.TargetDescription{
 		Id: aws.String(d.Get("target_id").(string)),
@@ -125,7 +130,7 @@ func resourceAwsLbAttachmentDelete(d *schema.ResourceData, meta interface{}) err
 // resourceAwsLbAttachmentRead requires all of the fields in order to describe the correct
 // target, so there is no work to do beyond ensuring that the target and group still exist.
 func resourceAwsLbAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbv2conn
+	elbconn := meta.(*AWSClient).elbv2conn()
 
 	target := &elbv2.TargetDescription{
 		Id: aws.String(d.Get("target_id").(string)),
@@ -189,3 +194,23 @@ func resourceAwsLbAttachmentRead(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+func resourceAwsLbAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	targetGroupArn, targetID, port, availabilityZone, err := tfelbv2.TargetGroupAttachmentParseImportID(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	//lintignore:R016 // Allow legacy unstable ID usage in managed resource
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", targetGroupArn)))
+	d.Set("target_group_arn", targetGroupArn)
+	d.Set("target_id", targetID)
+	if port != 0 {
+		d.Set("port", port)
+	}
+	if availabilityZone != "" {
+		d.Set("availability_zone", availabilityZone)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
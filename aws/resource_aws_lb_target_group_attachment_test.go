@@ -28,6 +28,12 @@ func TestAccAWSLBTargetGroupAttachment_basic(t *testing.T) {
 					testAccCheckAWSLBTargetGroupAttachmentExists("aws_lb_target_group_attachment.test"),
 				),
 			},
+			{
+				// The attachment ID is generated, so it differs after import.
+				ResourceName:      "aws_lb_target_group_attachment.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSLBTargetGroupAttachmentImportStateIdFunc("aws_lb_target_group_attachment.test"),
+			},
 		},
 	})
 }
@@ -135,7 +141,7 @@ func testAccCheckAWSLBTargetGroupAttachmentDisappears(n string) resource.TestChe
 			return fmt.Errorf("Attachment not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 		targetGroupArn := rs.Primary.Attributes["target_group_arn"]
 
 		target := &elbv2.TargetDescription{
@@ -173,7 +179,7 @@ func testAccCheckAWSLBTargetGroupAttachmentExists(n string) resource.TestCheckFu
 			return errors.New("No Target Group Attachment ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+		conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 		_, hasPort := rs.Primary.Attributes["port"]
 		targetGroupArn := rs.Primary.Attributes["target_group_arn"]
@@ -204,7 +210,7 @@ func testAccCheckAWSLBTargetGroupAttachmentExists(n string) resource.TestCheckFu
 }
 
 func testAccCheckAWSLBTargetGroupAttachmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbv2conn
+	conn := testAccProvider.Meta().(*AWSClient).elbv2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lb_target_group_attachment" && rs.Type != "aws_alb_target_group_attachment" {
@@ -425,3 +431,14 @@ resource "aws_lb_target_group_attachment" "test" {
 }
 `, rName)
 }
+
+func testAccAWSLBTargetGroupAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s,%s,%s", rs.Primary.Attributes["target_group_arn"], rs.Primary.Attributes["target_id"], rs.Primary.Attributes["port"]), nil
+	}
+}
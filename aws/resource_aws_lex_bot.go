@@ -191,7 +191,7 @@ var validateLexBotVersion = validation.All(
 )
 
 func resourceAwsLexBotCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 	name := d.Get("name").(string)
 
 	input := &lexmodelbuildingservice.PutBotInput{
@@ -249,7 +249,7 @@ func resourceAwsLexBotCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	resp, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
 		Name:           aws.String(d.Id()),
@@ -320,7 +320,7 @@ func resourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLexBotUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	input := &lexmodelbuildingservice.PutBotInput{
 		Checksum:                     aws.String(d.Get("checksum").(string)),
@@ -374,7 +374,7 @@ func resourceAwsLexBotUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLexBotDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	input := &lexmodelbuildingservice.DeleteBotInput{
 		Name: aws.String(d.Id()),
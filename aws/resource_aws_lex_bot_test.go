@@ -626,7 +626,7 @@ func testAccCheckAwsLexBotExistsWithVersion(rName, botVersion string, output *le
 		}
 
 		var err error
-		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
 
 		output, err = conn.GetBot(&lexmodelbuildingservice.GetBotInput{
 			Name:           aws.String(rs.Primary.ID),
@@ -649,7 +649,7 @@ func testAccCheckAwsLexBotExists(rName string, output *lexmodelbuildingservice.G
 
 func testAccCheckAwsLexBotNotExists(botName, botVersion string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
 
 		_, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
 			Name:           aws.String(botName),
@@ -667,7 +667,7 @@ func testAccCheckAwsLexBotNotExists(botName, botVersion string) resource.TestChe
 }
 
 func testAccCheckAwsLexBotDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_lex_bot" {
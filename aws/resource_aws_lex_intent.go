@@ -251,7 +251,7 @@ func resourceAwsLexIntent() *schema.Resource {
 }
 
 func resourceAwsLexIntentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 	name := d.Get("name").(string)
 
 	input := &lexmodelbuildingservice.PutIntentInput{
@@ -324,7 +324,7 @@ func resourceAwsLexIntentCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	resp, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
 		Name:    aws.String(d.Id()),
@@ -402,7 +402,7 @@ func resourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsLexIntentUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	input := &lexmodelbuildingservice.PutIntentInput{
 		Checksum:      aws.String(d.Get("checksum").(string)),
@@ -472,7 +472,7 @@ func resourceAwsLexIntentUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsLexIntentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lexmodelconn
+	conn := meta.(*AWSClient).lexmodelconn()
 
 	input := &lexmodelbuildingservice.DeleteIntentInput{
 		Name: aws.String(d.Id()),
@@ -68,7 +68,7 @@ func testAccCheckAWSLightsailDomainExists(n string, domain *lightsail.Domain) re
 			return errors.New("No Lightsail Domain ID is set")
 		}
 
-		conn := testAccProviderLightsailDomain.Meta().(*AWSClient).lightsailconn
+		conn := testAccProviderLightsailDomain.Meta().(*AWSClient).lightsailconn()
 
 		resp, err := conn.GetDomain(&lightsail.GetDomainInput{
 			DomainName: aws.String(rs.Primary.ID),
@@ -92,7 +92,7 @@ func testAccCheckAWSLightsailDomainDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProviderLightsailDomain.Meta().(*AWSClient).lightsailconn
+		conn := testAccProviderLightsailDomain.Meta().(*AWSClient).lightsailconn()
 
 		resp, err := conn.GetDomain(&lightsail.GetDomainInput{
 			DomainName: aws.String(rs.Primary.ID),
@@ -68,7 +68,7 @@ func resourceAwsLightsailInstancePublicPorts() *schema.Resource {
 }
 
 func resourceAwsLightsailInstancePublicPortsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 
 	var portInfos []*lightsail.PortInfo
 	if v, ok := d.GetOk("port_info"); ok && v.(*schema.Set).Len() > 0 {
@@ -97,7 +97,7 @@ func resourceAwsLightsailInstancePublicPortsCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsLightsailInstancePublicPortsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 
 	input := &lightsail.GetInstancePortStatesInput{
 		InstanceName: aws.String(d.Get("instance_name").(string)),
@@ -123,7 +123,7 @@ func resourceAwsLightsailInstancePublicPortsRead(d *schema.ResourceData, meta in
 }
 
 func resourceAwsLightsailInstancePublicPortsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 
 	var err *multierror.Error
 
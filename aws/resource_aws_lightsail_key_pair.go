@@ -77,7 +77,7 @@ func resourceAwsLightsailKeyPair() *schema.Resource {
 }
 
 func resourceAwsLightsailKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 
 	var kName string
 	if v, ok := d.GetOk("name"); ok {
@@ -116,12 +116,8 @@ func resourceAwsLightsailKeyPairCreate(d *schema.ResourceData, meta interface{})
 		d.Set("public_key", resp.PublicKeyBase64)
 
 		// encrypt private key if pgp_key is given
-		pgpKey, err := encryption.RetrieveGPGKey(d.Get("pgp_key").(string))
-		if err != nil {
-			return err
-		}
-		if pgpKey != "" {
-			fingerprint, encrypted, err := encryption.EncryptValue(pgpKey, *resp.PrivateKeyBase64, "Lightsail Private Key")
+		if v, ok := d.GetOk("pgp_key"); ok {
+			fingerprint, encrypted, err := encryption.Encrypt(v.(string), *resp.PrivateKeyBase64, "Lightsail Private Key", meta.(*AWSClient).kmsconn())
 			if err != nil {
 				return err
 			}
@@ -168,7 +164,7 @@ func resourceAwsLightsailKeyPairCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsLightsailKeyPairRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 
 	resp, err := conn.GetKeyPair(&lightsail.GetKeyPairInput{
 		KeyPairName: aws.String(d.Id()),
@@ -195,7 +191,7 @@ func resourceAwsLightsailKeyPairRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsLightsailKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).lightsailconn
+	conn := meta.(*AWSClient).lightsailconn()
 	resp, err := conn.DeleteKeyPair(&lightsail.DeleteKeyPairInput{
 		KeyPairName: aws.String(d.Id()),
 	})
@@ -17,6 +17,9 @@ func resourceAwsLoadBalancerBackendServerPolicies() *schema.Resource {
 		Read:   resourceAwsLoadBalancerBackendServerPoliciesRead,
 		Update: resourceAwsLoadBalancerBackendServerPoliciesCreate,
 		Delete: resourceAwsLoadBalancerBackendServerPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_name": {
@@ -40,7 +43,7 @@ func resourceAwsLoadBalancerBackendServerPolicies() *schema.Resource {
 }
 
 func resourceAwsLoadBalancerBackendServerPoliciesCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	loadBalancerName := d.Get("load_balancer_name")
 
@@ -64,7 +67,7 @@ func resourceAwsLoadBalancerBackendServerPoliciesCreate(d *schema.ResourceData,
 }
 
 func resourceAwsLoadBalancerBackendServerPoliciesRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	loadBalancerName, instancePort := resourceAwsLoadBalancerBackendServerPoliciesParseId(d.Id())
 
@@ -111,7 +114,7 @@ func resourceAwsLoadBalancerBackendServerPoliciesRead(d *schema.ResourceData, me
 }
 
 func resourceAwsLoadBalancerBackendServerPoliciesDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	loadBalancerName, instancePort := resourceAwsLoadBalancerBackendServerPoliciesParseId(d.Id())
 
@@ -17,6 +17,9 @@ func resourceAwsLoadBalancerListenerPolicies() *schema.Resource {
 		Read:   resourceAwsLoadBalancerListenerPoliciesRead,
 		Update: resourceAwsLoadBalancerListenerPoliciesCreate,
 		Delete: resourceAwsLoadBalancerListenerPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_name": {
@@ -40,7 +43,7 @@ func resourceAwsLoadBalancerListenerPolicies() *schema.Resource {
 }
 
 func resourceAwsLoadBalancerListenerPoliciesCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	loadBalancerName := d.Get("load_balancer_name")
 
@@ -64,7 +67,7 @@ func resourceAwsLoadBalancerListenerPoliciesCreate(d *schema.ResourceData, meta
 }
 
 func resourceAwsLoadBalancerListenerPoliciesRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	loadBalancerName, loadBalancerPort := resourceAwsLoadBalancerListenerPoliciesParseId(d.Id())
 
@@ -111,7 +114,7 @@ func resourceAwsLoadBalancerListenerPoliciesRead(d *schema.ResourceData, meta in
 }
 
 func resourceAwsLoadBalancerListenerPoliciesDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	loadBalancerName, loadBalancerPort := resourceAwsLoadBalancerListenerPoliciesParseId(d.Id())
 
@@ -30,6 +30,11 @@ func TestAccAWSLoadBalancerListenerPolicy_basic(t *testing.T) {
 					testAccCheckAWSLoadBalancerListenerPolicyState(lbName, int64(80), mcName, true),
 				),
 			},
+			{
+				ResourceName:      "aws_load_balancer_listener_policy.test-lb-listener-policies-80",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSLoadBalancerListenerPolicyConfig_basic1(lbName, mcName),
 				Check: resource.ComposeTestCheckFunc(
@@ -57,7 +62,7 @@ func policyInListenerPolicies(str string, list []string) bool {
 }
 
 func testAccCheckAWSLoadBalancerListenerPolicyDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).elbconn
+	conn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		switch {
@@ -116,7 +121,7 @@ func testAccCheckAWSLoadBalancerListenerPolicyDestroy(s *terraform.State) error
 
 func testAccCheckAWSLoadBalancerListenerPolicyState(loadBalancerName string, loadBalancerListenerPort int64, loadBalancerListenerPolicyName string, assigned bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		elbconn := testAccProvider.Meta().(*AWSClient).elbconn
+		elbconn := testAccProvider.Meta().(*AWSClient).elbconn()
 
 		loadBalancerDescription, err := elbconn.DescribeLoadBalancers(&elb.DescribeLoadBalancersInput{
 			LoadBalancerNames: []*string{aws.String(loadBalancerName)},
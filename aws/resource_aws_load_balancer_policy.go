@@ -17,6 +17,9 @@ func resourceAwsLoadBalancerPolicy() *schema.Resource {
 		Read:   resourceAwsLoadBalancerPolicyRead,
 		Update: resourceAwsLoadBalancerPolicyUpdate,
 		Delete: resourceAwsLoadBalancerPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_name": {
@@ -59,7 +62,7 @@ func resourceAwsLoadBalancerPolicy() *schema.Resource {
 }
 
 func resourceAwsLoadBalancerPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	attributes := []*elb.PolicyAttribute{}
 	if attributedata, ok := d.GetOk("policy_attribute"); ok {
@@ -91,7 +94,7 @@ func resourceAwsLoadBalancerPolicyCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsLoadBalancerPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	loadBalancerName, policyName := resourceAwsLoadBalancerPolicyParseId(d.Id())
 
@@ -146,7 +149,7 @@ func resourceAwsLoadBalancerPolicyRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsLoadBalancerPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 	reassignments := Reassignment{}
 
 	loadBalancerName, policyName := resourceAwsLoadBalancerPolicyParseId(d.Id())
@@ -193,7 +196,7 @@ func resourceAwsLoadBalancerPolicyUpdate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsLoadBalancerPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	elbconn := meta.(*AWSClient).elbconn
+	elbconn := meta.(*AWSClient).elbconn()
 
 	loadBalancerName, policyName := resourceAwsLoadBalancerPolicyParseId(d.Id())
 
@@ -53,7 +53,7 @@ func TestAccAWSMacieMemberAccountAssociation_self(t *testing.T) {
 }
 
 func testAccCheckAWSMacieMemberAccountAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).macieconn
+	conn := testAccProvider.Meta().(*AWSClient).macieconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_macie_member_account_association" {
@@ -86,7 +86,7 @@ func testAccCheckAWSMacieMemberAccountAssociationDestroy(s *terraform.State) err
 
 func testAccCheckAWSMacieMemberAccountAssociationExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).macieconn
+		conn := testAccProvider.Meta().(*AWSClient).macieconn()
 
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
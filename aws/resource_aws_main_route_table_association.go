@@ -16,6 +16,10 @@ func resourceAwsMainRouteTableAssociation() *schema.Resource {
 		Update: resourceAwsMainRouteTableAssociationUpdate,
 		Delete: resourceAwsMainRouteTableAssociationDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsMainRouteTableAssociationImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -40,7 +44,7 @@ func resourceAwsMainRouteTableAssociation() *schema.Resource {
 }
 
 func resourceAwsMainRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	vpcId := d.Get("vpc_id").(string)
 	routeTableId := d.Get("route_table_id").(string)
 
@@ -72,7 +76,7 @@ func resourceAwsMainRouteTableAssociationCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsMainRouteTableAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	mainAssociation, err := findMainRouteTableAssociation(
 		conn,
@@ -84,16 +88,43 @@ func resourceAwsMainRouteTableAssociationRead(d *schema.ResourceData, meta inter
 	if mainAssociation == nil || *mainAssociation.RouteTableAssociationId != d.Id() {
 		// It seems it doesn't exist anymore, so clear the ID
 		d.SetId("")
+		return nil
 	}
 
+	d.Set("route_table_id", mainAssociation.RouteTableId)
+
 	return nil
 }
 
+// The import ID is the VPC ID, as the main route table association is found by VPC.
+// The route table that is main at import time is recorded as the original route table,
+// since the route table created with the VPC cannot be determined.
+func resourceAwsMainRouteTableAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn()
+	vpcId := d.Id()
+
+	mainAssociation, err := findMainRouteTableAssociation(conn, vpcId)
+
+	if err != nil {
+		return nil, fmt.Errorf("error finding EC2 VPC (%s) main route table association: %w", vpcId, err)
+	}
+
+	if mainAssociation == nil {
+		return nil, fmt.Errorf("error finding EC2 VPC (%s) main route table association: association not found", vpcId)
+	}
+
+	d.SetId(aws.StringValue(mainAssociation.RouteTableAssociationId))
+	d.Set("vpc_id", vpcId)
+	d.Set("original_route_table_id", mainAssociation.RouteTableId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // Update is almost exactly like Create, except we want to retain the
 // original_route_table_id - this needs to stay recorded as the AWS-created
 // table from VPC creation.
 func resourceAwsMainRouteTableAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	vpcId := d.Get("vpc_id").(string)
 	routeTableId := d.Get("route_table_id").(string)
 
@@ -114,7 +145,7 @@ func resourceAwsMainRouteTableAssociationUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsMainRouteTableAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	vpcId := d.Get("vpc_id").(string)
 	originalRouteTableId := d.Get("original_route_table_id").(string)
 
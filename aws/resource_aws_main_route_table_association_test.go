@@ -23,6 +23,13 @@ func TestAccAWSMainRouteTableAssociation_basic(t *testing.T) {
 					testAccCheckMainRouteTableAssociation("aws_main_route_table_association.foo", "aws_vpc.foo"),
 				),
 			},
+			{
+				ResourceName:            "aws_main_route_table_association.foo",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccMainRouteTableAssociationImportStateIdFunc("aws_main_route_table_association.foo"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"original_route_table_id"},
+			},
 			{
 				Config: testAccMainRouteTableAssociationConfigUpdate,
 				Check: resource.ComposeTestCheckFunc(
@@ -33,8 +40,19 @@ func TestAccAWSMainRouteTableAssociation_basic(t *testing.T) {
 	})
 }
 
+func testAccMainRouteTableAssociationImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["vpc_id"], nil
+	}
+}
+
 func testAccCheckMainRouteTableAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_main_route_table_association" {
@@ -77,7 +95,7 @@ func testAccCheckMainRouteTableAssociation(mainRouteTableAssociationResource str
 			return fmt.Errorf("Not found: %s", vpcResource)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		mainAssociation, err := findMainRouteTableAssociation(conn, vpc.Primary.ID)
 		if err != nil {
 			return err
@@ -56,7 +56,7 @@ func resourceAwsMskConfiguration() *schema.Resource {
 }
 
 func resourceAwsMskConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	input := &kafka.CreateConfigurationInput{
 		KafkaVersions:    expandStringSet(d.Get("kafka_versions").(*schema.Set)),
@@ -80,7 +80,7 @@ func resourceAwsMskConfigurationCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsMskConfigurationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	configurationInput := &kafka.DescribeConfigurationInput{
 		Arn: aws.String(d.Id()),
@@ -137,7 +137,7 @@ func resourceAwsMskConfigurationRead(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsMskConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	input := &kafka.UpdateConfigurationInput{
 		Arn:              aws.String(d.Id()),
@@ -158,7 +158,7 @@ func resourceAwsMskConfigurationUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsMskConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	input := &kafka.DeleteConfigurationInput{
 		Arn: aws.String(d.Id()),
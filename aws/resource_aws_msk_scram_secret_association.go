@@ -47,7 +47,7 @@ func resourceAwsMskScramSecretAssociation() *schema.Resource {
 }
 
 func resourceAwsMskScramSecretAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	clusterArn := d.Get("cluster_arn").(string)
 	secretArnList := expandStringSet(d.Get("secret_arn_list").(*schema.Set))
@@ -67,7 +67,7 @@ func resourceAwsMskScramSecretAssociationCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsMskScramSecretAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	secretArnList, err := finder.ScramSecrets(conn, d.Id())
 
@@ -89,7 +89,7 @@ func resourceAwsMskScramSecretAssociationRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsMskScramSecretAssociationUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	o, n := d.GetChange("secret_arn_list")
 	oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
@@ -124,7 +124,7 @@ func resourceAwsMskScramSecretAssociationUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsMskScramSecretAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).kafkaconn
+	conn := meta.(*AWSClient).kafkaconn()
 
 	secretArnList, err := finder.ScramSecrets(conn, d.Id())
 
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2/finder"
 )
 
 func resourceAwsNetworkInterfaceAttachment() *schema.Resource {
@@ -18,6 +19,10 @@ func resourceAwsNetworkInterfaceAttachment() *schema.Resource {
 		Read:   resourceAwsNetworkInterfaceAttachmentRead,
 		Delete: resourceAwsNetworkInterfaceAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkInterfaceAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"device_index": {
 				Type:     schema.TypeInt,
@@ -51,7 +56,7 @@ func resourceAwsNetworkInterfaceAttachment() *schema.Resource {
 }
 
 func resourceAwsNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	device_index := d.Get("device_index").(int)
 	instance_id := d.Get("instance_id").(string)
@@ -93,7 +98,7 @@ func resourceAwsNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	interfaceId := d.Get("network_interface_id").(string)
 
@@ -132,8 +137,26 @@ func resourceAwsNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceAwsNetworkInterfaceAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn()
+
+	eni, err := finder.NetworkInterfaceByAttachmentID(conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading EC2 Network Interface for attachment (%s): %w", d.Id(), err)
+	}
+
+	if eni == nil {
+		return nil, fmt.Errorf("error reading EC2 Network Interface for attachment (%s): not found", d.Id())
+	}
+
+	d.Set("network_interface_id", eni.NetworkInterfaceId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	interfaceId := d.Get("network_interface_id").(string)
 
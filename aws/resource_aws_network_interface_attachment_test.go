@@ -36,6 +36,11 @@ func TestAccAWSNetworkInterfaceAttachment_basic(t *testing.T) {
 						"aws_network_interface_attachment.test", "status"),
 				),
 			},
+			{
+				ResourceName:      "aws_network_interface_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
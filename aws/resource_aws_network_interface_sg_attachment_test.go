@@ -167,7 +167,7 @@ func testAccCheckAWSNetworkInterfaceSGAttachmentExists(resourceName string, netw
 			return fmt.Errorf("No resource ID set: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		networkInterfaceID := rs.Primary.Attributes["network_interface_id"]
 		securityGroupID := rs.Primary.Attributes["security_group_id"]
 
@@ -187,7 +187,7 @@ func testAccCheckAWSNetworkInterfaceSGAttachmentExists(resourceName string, netw
 }
 
 func testAccCheckAWSNetworkInterfaceSGAttachmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_network_interface_sg_attachment" {
@@ -222,7 +222,7 @@ func testAccCheckAwsNetworkInterfaceSGAttachmentDisappears(resourceName string,
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 		securityGroupID := rs.Primary.Attributes["security_group_id"]
 
 		return delSGFromENI(conn, securityGroupID, networkInterface)
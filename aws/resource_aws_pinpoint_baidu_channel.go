@@ -45,7 +45,7 @@ func resourceAwsPinpointBaiduChannel() *schema.Resource {
 }
 
 func resourceAwsPinpointBaiduChannelUpsert(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	applicationId := d.Get("application_id").(string)
 
@@ -71,7 +71,7 @@ func resourceAwsPinpointBaiduChannelUpsert(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsPinpointBaiduChannelRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[INFO] Reading Pinpoint Baidu Channel for application %s", d.Id())
 
@@ -96,7 +96,7 @@ func resourceAwsPinpointBaiduChannelRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsPinpointBaiduChannelDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).pinpointconn
+	conn := meta.(*AWSClient).pinpointconn()
 
 	log.Printf("[DEBUG] Deleting Pinpoint Baidu Channel for application %s", d.Id())
 	_, err := conn.DeleteBaiduChannel(&pinpoint.DeleteBaiduChannelInput{
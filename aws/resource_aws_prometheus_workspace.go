@@ -42,7 +42,7 @@ func resourceAwsPrometheusWorkspace() *schema.Resource {
 
 func resourceAwsPrometheusWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Reading AMP workspace %s", d.Id())
-	conn := meta.(*AWSClient).prometheusserviceconn
+	conn := meta.(*AWSClient).prometheusserviceconn()
 
 	details, err := conn.DescribeWorkspaceWithContext(ctx, &prometheusservice.DescribeWorkspaceInput{
 		WorkspaceId: aws.String(d.Id()),
@@ -79,7 +79,7 @@ func resourceAwsPrometheusWorkspaceUpdate(ctx context.Context, d *schema.Resourc
 	if v, ok := d.GetOk("alias"); ok {
 		req.Alias = aws.String(v.(string))
 	}
-	conn := meta.(*AWSClient).prometheusserviceconn
+	conn := meta.(*AWSClient).prometheusserviceconn()
 	if _, err := conn.UpdateWorkspaceAliasWithContext(ctx, req); err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Prometheus WorkSpace (%s): %w", d.Id(), err))
 	}
@@ -89,7 +89,7 @@ func resourceAwsPrometheusWorkspaceUpdate(ctx context.Context, d *schema.Resourc
 
 func resourceAwsPrometheusWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Creating AMP workspace %s", d.Id())
-	conn := meta.(*AWSClient).prometheusserviceconn
+	conn := meta.(*AWSClient).prometheusserviceconn()
 
 	req := &prometheusservice.CreateWorkspaceInput{}
 	if v, ok := d.GetOk("alias"); ok {
@@ -111,7 +111,7 @@ func resourceAwsPrometheusWorkspaceCreate(ctx context.Context, d *schema.Resourc
 
 func resourceAwsPrometheusWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] Deleting AMP workspace %s", d.Id())
-	conn := meta.(*AWSClient).prometheusserviceconn
+	conn := meta.(*AWSClient).prometheusserviceconn()
 
 	_, err := conn.DeleteWorkspaceWithContext(ctx, &prometheusservice.DeleteWorkspaceInput{
 		WorkspaceId: aws.String(d.Id()),
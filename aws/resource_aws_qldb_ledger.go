@@ -12,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/qldb/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/qldb/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsQLDBLedger() *schema.Resource {
@@ -24,6 +27,8 @@ func resourceAwsQLDBLedger() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -47,13 +52,17 @@ func resourceAwsQLDBLedger() *schema.Resource {
 				Default:  true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 		},
 	}
 }
 
 func resourceAwsQLDBLedgerCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).qldbconn
+	conn := meta.(*AWSClient).qldbconn()
+
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
 	var name string
 	if v, ok := d.GetOk("name"); ok {
@@ -72,7 +81,7 @@ func resourceAwsQLDBLedgerCreate(d *schema.ResourceData, meta interface{}) error
 		Name:               aws.String(d.Get("name").(string)),
 		PermissionsMode:    aws.String(qldb.PermissionsModeAllowAll),
 		DeletionProtection: aws.Bool(d.Get("deletion_protection").(bool)),
-		Tags:               keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().QldbTags(),
+		Tags:               tags.IgnoreAws().QldbTags(),
 	}
 
 	log.Printf("[DEBUG] QLDB Ledger create config: %#v", *createOpts)
@@ -86,17 +95,8 @@ func resourceAwsQLDBLedgerCreate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[INFO] QLDB Ledger name: %s", d.Id())
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    []string{qldb.LedgerStateCreating},
-		Target:     []string{qldb.LedgerStateActive},
-		Refresh:    qldbLedgerRefreshStatusFunc(conn, d.Id()),
-		Timeout:    8 * time.Minute,
-		MinTimeout: 3 * time.Second,
-	}
-
-	_, err = stateConf.WaitForState()
-	if err != nil {
-		return fmt.Errorf("Error waiting for QLDB Ledger status to be \"%s\": %s", qldb.LedgerStateActive, err)
+	if _, err := waiter.LedgerCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for QLDB Ledger (%s) creation: %w", d.Id(), err)
 	}
 
 	// Update our attributes and return
@@ -104,17 +104,13 @@ func resourceAwsQLDBLedgerCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsQLDBLedgerRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).qldbconn
+	conn := meta.(*AWSClient).qldbconn()
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
-	// Refresh the QLDB state
-	input := &qldb.DescribeLedgerInput{
-		Name: aws.String(d.Id()),
-	}
-
-	qldbLedger, err := conn.DescribeLedger(input)
+	qldbLedger, err := finder.LedgerByID(conn, d.Id())
 
-	if isAWSErr(err, qldb.ErrCodeResourceNotFoundException, "") {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] QLDB Ledger (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -145,15 +141,22 @@ func resourceAwsQLDBLedgerRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error listing tags for QLDB Ledger: %s", err)
 	}
 
-	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
 func resourceAwsQLDBLedgerUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).qldbconn
+	conn := meta.(*AWSClient).qldbconn()
 
 	if d.HasChange("deletion_protection") {
 		val := d.Get("deletion_protection").(bool)
@@ -170,8 +173,8 @@ func resourceAwsQLDBLedgerUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 		if err := keyvaluetags.QldbUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
 		}
@@ -181,7 +184,7 @@ func resourceAwsQLDBLedgerUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceAwsQLDBLedgerDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).qldbconn
+	conn := meta.(*AWSClient).qldbconn()
 	deleteLedgerOpts := &qldb.DeleteLedgerInput{
 		Name: aws.String(d.Id()),
 	}
@@ -213,52 +216,9 @@ func resourceAwsQLDBLedgerDelete(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error deleting QLDB Ledger (%s): %s", d.Id(), err)
 	}
 
-	if err := waitForQLDBLedgerDeletion(conn, d.Id()); err != nil {
+	if _, err := waiter.LedgerDeleted(conn, d.Id()); err != nil {
 		return fmt.Errorf("error waiting for QLDB Ledger (%s) deletion: %s", d.Id(), err)
 	}
 
 	return nil
 }
-
-func qldbLedgerRefreshStatusFunc(conn *qldb.QLDB, ledger string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		input := &qldb.DescribeLedgerInput{
-			Name: aws.String(ledger),
-		}
-		resp, err := conn.DescribeLedger(input)
-		if err != nil {
-			return nil, "failed", err
-		}
-		return resp, aws.StringValue(resp.State), nil
-	}
-}
-
-func waitForQLDBLedgerDeletion(conn *qldb.QLDB, ledgerName string) error {
-	stateConf := resource.StateChangeConf{
-		Pending: []string{qldb.LedgerStateCreating,
-			qldb.LedgerStateActive,
-			qldb.LedgerStateDeleting},
-		Target:     []string{""},
-		Timeout:    5 * time.Minute,
-		MinTimeout: 1 * time.Second,
-		Refresh: func() (interface{}, string, error) {
-			resp, err := conn.DescribeLedger(&qldb.DescribeLedgerInput{
-				Name: aws.String(ledgerName),
-			})
-
-			if isAWSErr(err, qldb.ErrCodeResourceNotFoundException, "") {
-				return 1, "", nil
-			}
-
-			if err != nil {
-				return nil, qldb.ErrCodeResourceInUseException, err
-			}
-
-			return resp, aws.StringValue(resp.State), nil
-		},
-	}
-
-	_, err := stateConf.WaitForState()
-
-	return err
-}
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"fmt"
-	"log"
 	"regexp"
 	"testing"
 
@@ -12,54 +11,42 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_qldb_ledger", &resource.Sweeper{
-		Name: "aws_qldb_ledger",
-		F:    testSweepQLDBLedgers,
+	addSweeper(&sweep.Sweeper{
+		Name:    "aws_qldb_ledger",
+		Service: qldb.ServiceName,
+		List:    testSweepQLDBLedgers,
 	})
 }
 
-func testSweepQLDBLedgers(region string) error {
+func testSweepQLDBLedgers(region string) ([]*sweep.Resource, error) {
 	client, err := sharedClientForRegion(region)
 
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return nil, fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).qldbconn
+	conn := client.(*AWSClient).qldbconn()
 	input := &qldb.ListLedgersInput{}
-	page, err := conn.ListLedgers(input)
+	var resources []*sweep.Resource
 
-	if err != nil {
-		if testSweepSkipSweepError(err) {
-			log.Printf("[WARN] Skipping QLDB Ledger sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error listing QLDB Ledgers: %s", err)
-	}
-
-	for _, item := range page.Ledgers {
-		input := &qldb.DeleteLedgerInput{
-			Name: item.Name,
-		}
-		name := aws.StringValue(item.Name)
-
-		log.Printf("[INFO] Deleting QLDB Ledger: %s", name)
-		_, err = conn.DeleteLedger(input)
+	err = conn.ListLedgersPages(input, func(page *qldb.ListLedgersOutput, lastPage bool) bool {
+		for _, item := range page.Ledgers {
+			name := aws.StringValue(item.Name)
 
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete QLDB Ledger %s: %s", name, err)
-			continue
+			resources = append(resources, &sweep.Resource{
+				ID:     name,
+				Delete: sweepSchemaResource(resourceAwsQLDBLedger(), name, client),
+			})
 		}
 
-		if err := waitForQLDBLedgerDeletion(conn, name); err != nil {
-			log.Printf("[ERROR] Error waiting for QLDB Ledger (%s) deletion: %s", name, err)
-		}
-	}
+		return !lastPage
+	})
 
-	return nil
+	return resources, err
 }
 
 func TestAccAWSQLDBLedger_basic(t *testing.T) {
@@ -97,7 +84,7 @@ func testAccCheckAWSQLDBLedgerDestroy(s *terraform.State) error {
 }
 
 func testAccCheckAWSLedgerDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
-	conn := provider.Meta().(*AWSClient).qldbconn
+	conn := provider.Meta().(*AWSClient).qldbconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_qldb_ledger" {
@@ -141,7 +128,7 @@ func testAccCheckAWSQLDBLedgerExists(n string, v *qldb.DescribeLedgerOutput) res
 			return fmt.Errorf("No QLDB Ledger ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).qldbconn
+		conn := testAccProvider.Meta().(*AWSClient).qldbconn()
 		resp, err := conn.DescribeLedger(&qldb.DescribeLedgerInput{
 			Name: aws.String(rs.Primary.ID),
 		})
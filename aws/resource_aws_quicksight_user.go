@@ -90,7 +90,7 @@ func resourceAwsQuickSightUser() *schema.Resource {
 }
 
 func resourceAwsQuickSightUserCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	awsAccountID := meta.(*AWSClient).accountid
 
@@ -131,7 +131,7 @@ func resourceAwsQuickSightUserCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsQuickSightUserRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	awsAccountID, namespace, userName, err := resourceAwsQuickSightUserParseID(d.Id())
 	if err != nil {
@@ -165,7 +165,7 @@ func resourceAwsQuickSightUserRead(d *schema.ResourceData, meta interface{}) err
 }
 
 func resourceAwsQuickSightUserUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	awsAccountID, namespace, userName, err := resourceAwsQuickSightUserParseID(d.Id())
 	if err != nil {
@@ -194,7 +194,7 @@ func resourceAwsQuickSightUserUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsQuickSightUserDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).quicksightconn
+	conn := meta.(*AWSClient).quicksightconn()
 
 	awsAccountID, namespace, userName, err := resourceAwsQuickSightUserParseID(d.Id())
 	if err != nil {
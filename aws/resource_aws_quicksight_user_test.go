@@ -110,7 +110,7 @@ func testAccCheckQuickSightUserExists(resourceName string, user *quicksight.User
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn()
 
 		input := &quicksight.DescribeUserInput{
 			AwsAccountId: aws.String(awsAccountID),
@@ -135,7 +135,7 @@ func testAccCheckQuickSightUserExists(resourceName string, user *quicksight.User
 }
 
 func testAccCheckQuickSightUserDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+	conn := testAccProvider.Meta().(*AWSClient).quicksightconn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_quicksight_user" {
 			continue
@@ -167,7 +167,7 @@ func testAccCheckQuickSightUserDestroy(s *terraform.State) error {
 
 func testAccCheckQuickSightUserDisappears(v *quicksight.User) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).quicksightconn
+		conn := testAccProvider.Meta().(*AWSClient).quicksightconn()
 
 		arn, err := arn.Parse(aws.StringValue(v.Arn))
 		if err != nil {
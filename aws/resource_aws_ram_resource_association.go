@@ -39,7 +39,7 @@ func resourceAwsRamResourceAssociation() *schema.Resource {
 }
 
 func resourceAwsRamResourceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 	resourceARN := d.Get("resource_arn").(string)
 	resourceShareARN := d.Get("resource_share_arn").(string)
 
@@ -65,7 +65,7 @@ func resourceAwsRamResourceAssociationCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsRamResourceAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	resourceShareARN, resourceARN, err := decodeRamResourceAssociationID(d.Id())
 	if err != nil {
@@ -97,7 +97,7 @@ func resourceAwsRamResourceAssociationRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsRamResourceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	resourceShareARN, resourceARN, err := decodeRamResourceAssociationID(d.Id())
 	if err != nil {
@@ -77,7 +77,7 @@ func resourceAwsRamResourceShareAccepter() *schema.Resource {
 }
 
 func resourceAwsRamResourceShareAccepterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	shareARN := d.Get("share_arn").(string)
 
@@ -128,7 +128,7 @@ func resourceAwsRamResourceShareAccepterCreate(d *schema.ResourceData, meta inte
 
 func resourceAwsRamResourceShareAccepterRead(d *schema.ResourceData, meta interface{}) error {
 	accountID := meta.(*AWSClient).accountid
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	invitation, err := resourceAwsRamResourceShareGetInvitation(conn, d.Id(), ram.ResourceShareInvitationStatusAccepted)
 
@@ -194,7 +194,7 @@ func resourceAwsRamResourceShareAccepterRead(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsRamResourceShareAccepterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ramconn
+	conn := meta.(*AWSClient).ramconn()
 
 	receiverAccountID := d.Get("receiver_account_id").(string)
 
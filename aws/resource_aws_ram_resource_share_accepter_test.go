@@ -54,7 +54,7 @@ func TestAccAwsRamResourceShareAccepter_basic(t *testing.T) {
 }
 
 func testAccCheckAwsRamResourceShareAccepterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ramconn
+	conn := testAccProvider.Meta().(*AWSClient).ramconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ram_resource_share_accepter" {
@@ -90,7 +90,7 @@ func testAccCheckAwsRamResourceShareAccepterExists(name string) resource.TestChe
 			return fmt.Errorf("RAM resource share invitation not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ramconn
+		conn := testAccProvider.Meta().(*AWSClient).ramconn()
 
 		input := &ram.GetResourceSharesInput{
 			ResourceShareArns: []*string{aws.String(rs.Primary.Attributes["share_arn"])},
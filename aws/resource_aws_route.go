@@ -154,7 +154,7 @@ func resourceAwsRoute() *schema.Resource {
 }
 
 func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	var numTargets int
 	var setTarget string
 	allowedTargets := []string{
@@ -374,7 +374,7 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	routeTableId := d.Get("route_table_id").(string)
 	destinationCidrBlock := d.Get("destination_cidr_block").(string)
@@ -420,7 +420,7 @@ func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRouteUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 	var numTargets int
 	var setTarget string
 
@@ -525,7 +525,7 @@ func resourceAwsRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceAwsRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	deleteOpts := &ec2.DeleteRouteInput{
 		RouteTableId: aws.String(d.Get("route_table_id").(string)),
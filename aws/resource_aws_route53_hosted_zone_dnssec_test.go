@@ -105,7 +105,7 @@ func TestAccAwsRoute53HostedZoneDnssec_SigningStatus(t *testing.T) {
 }
 
 func testAccCheckAwsRoute53HostedZoneDnssecDestroy(s *terraform.State) error {
-	conn := testAccProviderRoute53KeySigningKey.Meta().(*AWSClient).r53conn
+	conn := testAccProviderRoute53KeySigningKey.Meta().(*AWSClient).r53conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_route53_hosted_zone_dnssec" {
@@ -146,7 +146,7 @@ func testAccAwsRoute53HostedZoneDnssecExists(resourceName string) resource.TestC
 			return fmt.Errorf("resource %s has not set its id", resourceName)
 		}
 
-		conn := testAccProviderRoute53KeySigningKey.Meta().(*AWSClient).r53conn
+		conn := testAccProviderRoute53KeySigningKey.Meta().(*AWSClient).r53conn()
 
 		hostedZoneDnssec, err := finder.HostedZoneDnssec(conn, rs.Primary.ID)
 
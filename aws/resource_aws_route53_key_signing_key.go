@@ -101,7 +101,7 @@ func resourceAwsRoute53KeySigningKey() *schema.Resource {
 }
 
 func resourceAwsRoute53KeySigningKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	hostedZoneID := d.Get("hosted_zone_id").(string)
 	name := d.Get("name").(string)
@@ -140,7 +140,7 @@ func resourceAwsRoute53KeySigningKeyCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsRoute53KeySigningKeyRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	hostedZoneID, name, err := tfroute53.KeySigningKeyParseResourceID(d.Id())
 
@@ -195,7 +195,7 @@ func resourceAwsRoute53KeySigningKeyRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsRoute53KeySigningKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	if d.HasChange("status") {
 		status := d.Get("status").(string)
@@ -248,7 +248,7 @@ func resourceAwsRoute53KeySigningKeyUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsRoute53KeySigningKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).r53conn
+	conn := meta.(*AWSClient).r53conn()
 
 	status := d.Get("status").(string)
 
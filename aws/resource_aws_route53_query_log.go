@@ -36,7 +36,7 @@ func resourceAwsRoute53QueryLog() *schema.Resource {
 }
 
 func resourceAwsRoute53QueryLogCreate(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).r53conn()
 
 	input := &route53.CreateQueryLoggingConfigInput{
 		CloudWatchLogsLogGroupArn: aws.String(d.Get("cloudwatch_log_group_arn").(string)),
@@ -56,7 +56,7 @@ func resourceAwsRoute53QueryLogCreate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAwsRoute53QueryLogRead(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).r53conn()
 
 	input := &route53.GetQueryLoggingConfigInput{
 		Id: aws.String(d.Id()),
@@ -75,7 +75,7 @@ func resourceAwsRoute53QueryLogRead(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceAwsRoute53QueryLogDelete(d *schema.ResourceData, meta interface{}) error {
-	r53 := meta.(*AWSClient).r53conn
+	r53 := meta.(*AWSClient).r53conn()
 
 	input := &route53.DeleteQueryLoggingConfigInput{
 		Id: aws.String(d.Id()),
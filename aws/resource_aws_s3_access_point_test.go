@@ -29,7 +29,7 @@ func testSweepS3AccessPoints(region string) error {
 	}
 
 	accountId := client.(*AWSClient).accountid
-	conn := client.(*AWSClient).s3controlconn
+	conn := client.(*AWSClient).s3controlconn()
 
 	input := &s3control.ListAccessPointsInput{
 		AccountId: aws.String(accountId),
@@ -399,7 +399,7 @@ func testAccCheckAWSS3AccessPointDisappears(n string) resource.TestCheckFunc {
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		_, err = conn.DeleteAccessPoint(&s3control.DeleteAccessPointInput{
 			AccountId: aws.String(accountId),
@@ -414,7 +414,7 @@ func testAccCheckAWSS3AccessPointDisappears(n string) resource.TestCheckFunc {
 }
 
 func testAccCheckAWSS3AccessPointDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_access_point" {
@@ -453,7 +453,7 @@ func testAccCheckAWSS3AccessPointExists(n string, output *s3control.GetAccessPoi
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		resp, err := conn.GetAccessPoint(&s3control.GetAccessPointInput{
 			AccountId: aws.String(accountId),
@@ -485,7 +485,7 @@ func testAccCheckAWSS3AccessPointHasPolicy(n string, fn func() string) resource.
 			return err
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		resp, err := conn.GetAccessPointPolicy(&s3control.GetAccessPointPolicyInput{
 			AccountId: aws.String(accountId),
@@ -290,7 +290,7 @@ func testAccCheckAWSS3AccountPublicAccessBlockExists(resourceName string, config
 			return fmt.Errorf("No S3 Account Public Access Block ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 		input := &s3control.GetPublicAccessBlockInput{
 			AccountId: aws.String(rs.Primary.ID),
@@ -328,7 +328,7 @@ func testAccCheckAWSS3AccountPublicAccessBlockExists(resourceName string, config
 }
 
 func testAccCheckAWSS3AccountPublicAccessBlockDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_account_public_access_block" {
@@ -364,7 +364,7 @@ func testAccCheckAWSS3AccountPublicAccessBlockDestroy(s *terraform.State) error
 
 func testAccCheckAWSS3AccountPublicAccessBlockDisappears() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn()
 		accountID := testAccProvider.Meta().(*AWSClient).accountid
 
 		deleteInput := &s3control.DeletePublicAccessBlockInput{
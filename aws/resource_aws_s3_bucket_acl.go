@@ -0,0 +1,144 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsS3BucketAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketAclPut,
+		Read:   resourceAwsS3BucketAclRead,
+		Update: resourceAwsS3BucketAclPut,
+		Delete: resourceAwsS3BucketAclDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"acl", "grant"},
+				ValidateFunc: validation.StringInSlice(BucketCannedACL_Values(), false),
+			},
+			"grant": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"acl", "grant"},
+				Set:          grantHash,
+				Elem:         s3BucketGrantSchema(),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketAclPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = aws.String(v.(string))
+	} else {
+		outputRaw, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+			return conn.GetBucketAcl(&s3.GetBucketAclInput{
+				Bucket: aws.String(bucket),
+			})
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Bucket (%s) ACL owner: %w", bucket, err)
+		}
+
+		input.AccessControlPolicy = &s3.AccessControlPolicy{
+			Grants: expandS3BucketGrants(d.Get("grant").(*schema.Set).List()),
+			Owner:  outputRaw.(*s3.GetBucketAclOutput).Owner,
+		}
+	}
+
+	_, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+		return conn.PutBucketAcl(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error putting S3 Bucket (%s) ACL: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceAwsS3BucketAclRead(d, meta)
+}
+
+func resourceAwsS3BucketAclRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	output, err := conn.GetBucketAcl(&s3.GetBucketAclInput{
+		Bucket: aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: empty response", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	// A canned ACL cannot be read back, so the grants are only tracked when configured.
+	if _, ok := d.GetOk("acl"); ok {
+		return nil
+	}
+
+	if err := d.Set("grant", flattenGrants(output)); err != nil {
+		return fmt.Errorf("error setting grant: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketAclDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	// A bucket always has an ACL, so deletion restores the default.
+	_, err := conn.PutBucketAcl(&s3.PutBucketAclInput{
+		Bucket: aws.String(d.Id()),
+		ACL:    aws.String(s3.BucketCannedACLPrivate),
+	})
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	return nil
+}
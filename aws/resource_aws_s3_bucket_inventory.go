@@ -183,7 +183,7 @@ func resourceAwsS3BucketInventory() *schema.Resource {
 }
 
 func resourceAwsS3BucketInventoryPut(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 	bucket := d.Get("bucket").(string)
 	name := d.Get("name").(string)
 
@@ -255,7 +255,7 @@ func resourceAwsS3BucketInventoryPut(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsS3BucketInventoryDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket, name, err := resourceAwsS3BucketInventoryParseID(d.Id())
 	if err != nil {
@@ -280,7 +280,7 @@ func resourceAwsS3BucketInventoryDelete(d *schema.ResourceData, meta interface{}
 }
 
 func resourceAwsS3BucketInventoryRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket, name, err := resourceAwsS3BucketInventoryParseID(d.Id())
 	if err != nil {
@@ -556,7 +556,7 @@ func TestAccAWSS3BucketMetric_WithFilterSingleTag(t *testing.T) {
 }
 
 func testAccCheckAWSS3BucketMetricDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).s3conn
+	conn := testAccProvider.Meta().(*AWSClient).s3conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_s3_bucket_metric" {
@@ -606,7 +606,7 @@ func testAccCheckAWSS3BucketMetricsConfigExists(n string, res *s3.MetricsConfigu
 			return fmt.Errorf("No S3 bucket metrics configuration ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		conn := testAccProvider.Meta().(*AWSClient).s3conn()
 		bucket, name, err := resourceAwsS3BucketMetricParseID(rs.Primary.ID)
 		if err != nil {
 			return err
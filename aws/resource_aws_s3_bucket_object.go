@@ -16,10 +16,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/go-homedir"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
 )
 
 func resourceAwsS3BucketObject() *schema.Resource {
@@ -29,7 +32,15 @@ func resourceAwsS3BucketObject() *schema.Resource {
 		Update: resourceAwsS3BucketObjectUpdate,
 		Delete: resourceAwsS3BucketObjectDelete,
 
-		CustomizeDiff: resourceAwsS3BucketObjectCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsS3BucketObjectImport,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceAwsS3BucketObjectCustomizeDiff,
+			SetTagsDiff,
+			customizeDiffValidateTags("s3object"),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -104,6 +115,25 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				ConflictsWith: []string{"source", "content"},
 			},
 
+			"source_hash": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(s3manager.DefaultUploadPartSize),
+				ValidateFunc: validation.IntAtLeast(int(s3manager.MinUploadPartSize)),
+			},
+
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadConcurrency,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
 			"storage_class": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -134,8 +164,8 @@ func resourceAwsS3BucketObject() *schema.Resource {
 
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload.
+				// The Etag then won't match raw-file MD5, use source_hash instead.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
@@ -147,7 +177,8 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaComputed(),
 
 			"website_redirect": {
 				Type:     schema.TypeString,
@@ -182,9 +213,11 @@ func resourceAwsS3BucketObject() *schema.Resource {
 }
 
 func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(keyvaluetags.New(d.Get("tags").(map[string]interface{})))
 
-	var body io.ReadSeeker
+	var body io.Reader
 
 	if v, ok := d.GetOk("source"); ok {
 		source := v.(string)
@@ -209,8 +242,6 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		body = bytes.NewReader([]byte(content))
 	} else if v, ok := d.GetOk("content_base64"); ok {
 		content := v.(string)
-		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
 		contentRaw, err := base64.StdEncoding.DecodeString(content)
 		if err != nil {
 			return fmt.Errorf("error decoding content_base64: %s", err)
@@ -218,10 +249,15 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		body = bytes.NewReader(contentRaw)
 	}
 
+	// The uploader always reads its input, even for an empty object.
+	if body == nil {
+		body = bytes.NewReader([]byte{})
+	}
+
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	putInput := &s3.PutObjectInput{
+	putInput := &s3manager.UploadInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(key),
 		ACL:    aws.String(d.Get("acl").(string)),
@@ -265,9 +301,9 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
 	}
 
-	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+	if len(tags) > 0 {
 		// The tag-set must be encoded as URL Query parameters.
-		putInput.Tagging = aws.String(keyvaluetags.New(v).IgnoreAws().UrlEncode())
+		putInput.Tagging = aws.String(tags.IgnoreAws().UrlEncode())
 	}
 
 	if v, ok := d.GetOk("website_redirect"); ok {
@@ -286,7 +322,12 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ObjectLockRetainUntilDate = expandS3ObjectDate(v.(string))
 	}
 
-	if _, err := s3conn.PutObject(putInput); err != nil {
+	uploader := s3manager.NewUploaderWithClient(s3conn, func(u *s3manager.Uploader) {
+		u.PartSize = int64(d.Get("upload_part_size").(int))
+		u.Concurrency = d.Get("upload_concurrency").(int)
+	})
+
+	if _, err := uploader.Upload(putInput); err != nil {
 		return fmt.Errorf("Error putting object in S3 bucket (%s): %s", bucket, err)
 	}
 
@@ -299,7 +340,8 @@ func resourceAwsS3BucketObjectCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
+	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	bucket := d.Get("bucket").(string)
@@ -350,7 +392,13 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// See https://forums.aws.amazon.com/thread.jspa?threadID=44003
-	d.Set("etag", strings.Trim(aws.StringValue(resp.ETag), `"`))
+	etag := strings.Trim(aws.StringValue(resp.ETag), `"`)
+
+	// The ETag of a multipart upload is not an MD5 digest of the object data,
+	// so keep any known value instead of reporting a perpetual difference.
+	if !isS3ObjectMultipartETag(etag) || d.Get("etag").(string) == "" {
+		d.Set("etag", etag)
+	}
 
 	// The "STANDARD" (which is also the default) storage
 	// class when set would not be included in the results.
@@ -360,7 +408,7 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	// Retry due to S3 eventual consistency
-	tags, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
+	tagsRaw, err := retryOnAwsCode(s3.ErrCodeNoSuchBucket, func() (interface{}, error) {
 		return keyvaluetags.S3ObjectListTags(s3conn, bucket, key)
 	})
 
@@ -368,19 +416,42 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error listing tags for S3 Bucket (%s) Object (%s): %s", bucket, key, err)
 	}
 
-	if err := d.Set("tags", tags.(keyvaluetags.KeyValueTags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := tagsRaw.(keyvaluetags.KeyValueTags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
 		return fmt.Errorf("error setting tags: %s", err)
 	}
 
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
+	}
+
 	return nil
 }
 
+func resourceAwsS3BucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	bucket, key, err := tfs3.BucketObjectParseImportID(d.Id())
+	if err != nil {
+		return []*schema.ResourceData{}, err
+	}
+
+	d.SetId(key)
+	d.Set("bucket", bucket)
+	d.Set("key", key)
+	d.Set("force_destroy", false)
+	d.Set("upload_part_size", s3manager.DefaultUploadPartSize)
+	d.Set("upload_concurrency", s3manager.DefaultUploadConcurrency)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) error {
 	if hasS3BucketObjectContentChanges(d) {
 		return resourceAwsS3BucketObjectPut(d, meta)
 	}
 
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -435,8 +506,8 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
-		o, n := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
 
 		if err := keyvaluetags.S3ObjectUpdateTags(conn, bucket, key, o, n); err != nil {
 			return fmt.Errorf("error updating tags: %s", err)
@@ -447,7 +518,7 @@ func resourceAwsS3BucketObjectUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceAwsS3BucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
-	s3conn := meta.(*AWSClient).s3conn
+	s3conn := meta.(*AWSClient).s3conn()
 
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
@@ -474,7 +545,7 @@ func resourceAwsS3BucketObjectSetKMS(d *schema.ResourceData, meta interface{}, s
 	// Only set non-default KMS key ID (one that doesn't match default)
 	if sseKMSKeyId != nil {
 		// retrieve S3 KMS Default Master Key
-		kmsconn := meta.(*AWSClient).kmsconn
+		kmsconn := meta.(*AWSClient).kmsconn()
 		kmsresp, err := kmsconn.DescribeKey(&kms.DescribeKeyInput{
 			KeyId: aws.String("alias/aws/s3"),
 		})
@@ -491,6 +562,12 @@ func resourceAwsS3BucketObjectSetKMS(d *schema.ResourceData, meta interface{}, s
 	return nil
 }
 
+// isS3ObjectMultipartETag returns whether an ETag was produced by a multipart upload,
+// in which case it has the form "<digest of part digests>-<number of parts>".
+func isS3ObjectMultipartETag(etag string) bool {
+	return strings.Contains(etag, "-")
+}
+
 func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(map[string]interface{})
 
@@ -524,6 +601,7 @@ func hasS3BucketObjectContentChanges(d resourceDiffer) bool {
 		"metadata",
 		"server_side_encryption",
 		"source",
+		"source_hash",
 		"storage_class",
 		"website_redirect",
 	} {
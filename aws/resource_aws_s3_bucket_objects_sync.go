@@ -0,0 +1,500 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfs3 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3/syncdir"
+)
+
+const (
+	// Maximum number of keys in a single DeleteObjects call.
+	s3BucketObjectsSyncDeleteBatchSize = 1000
+)
+
+func resourceAwsS3BucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3BucketObjectsSyncCreate,
+		Read:   resourceAwsS3BucketObjectsSyncRead,
+		Update: resourceAwsS3BucketObjectsSyncUpdate,
+		Delete: resourceAwsS3BucketObjectsSyncDelete,
+
+		CustomizeDiff: resourceAwsS3BucketObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"default_content_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"delete_stray_keys": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"manifest": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_disposition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateMetadataIsLowerCase,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+
+			"source_dir": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      s3manager.DefaultUploadConcurrency,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceAwsS3BucketObjectsSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(tfs3.BucketObjectsSyncCreateID(d.Get("bucket").(string), d.Get("key_prefix").(string)))
+
+	if err := resourceAwsS3BucketObjectsSyncPut(d, meta, map[string]string{}, false); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketObjectsSyncRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectsSyncRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+
+	// Objects are not read back individually, differences are computed from the manifest.
+	_, err := conn.HeadBucket(&s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if !d.IsNewResource() && (isAWSErrRequestFailureStatusCode(err, 404) || isAWSErr(err, s3.ErrCodeNoSuchBucket, "")) {
+		log.Printf("[WARN] S3 Bucket (%s) not found, removing S3 Bucket Objects Sync (%s) from state", bucket, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s): %w", bucket, err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsSyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	o, _ := d.GetChange("manifest")
+	uploadAll := d.HasChanges("acl", "kms_key_id", "server_side_encryption", "storage_class")
+
+	if err := resourceAwsS3BucketObjectsSyncPut(d, meta, aws.StringValueMap(stringMapToPointers(o.(map[string]interface{}))), uploadAll); err != nil {
+		return err
+	}
+
+	return resourceAwsS3BucketObjectsSyncRead(d, meta)
+}
+
+func resourceAwsS3BucketObjectsSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+
+	var keys []string
+	for k := range d.Get("manifest").(map[string]interface{}) {
+		keys = append(keys, k)
+	}
+
+	err := deleteS3BucketObjectsSyncKeys(conn, bucket, keys)
+
+	if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket Objects Sync (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3BucketObjectsSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"default_content_type", "exclude", "key_prefix", "rule", "source_dir"} {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("manifest")
+		}
+	}
+
+	files, err := syncdir.Scan(d.Get("source_dir").(string), expandS3BucketObjectsSyncOptions(d))
+
+	if err != nil {
+		return err
+	}
+
+	o := aws.StringValueMap(stringMapToPointers(d.Get("manifest").(map[string]interface{})))
+	n := syncdir.Manifest(files)
+
+	if upload, remove := syncdir.Diff(o, n); len(upload) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	return d.SetNew("manifest", n)
+}
+
+// resourceAwsS3BucketObjectsSyncPut uploads new and changed files and deletes the objects
+// of removed files, given the manifest of the previous synchronization.
+// The manifest in state always reflects the objects successfully written.
+func resourceAwsS3BucketObjectsSyncPut(d *schema.ResourceData, meta interface{}, old map[string]string, uploadAll bool) error {
+	conn := meta.(*AWSClient).s3conn()
+
+	bucket := d.Get("bucket").(string)
+
+	files, err := syncdir.Scan(d.Get("source_dir").(string), expandS3BucketObjectsSyncOptions(d))
+
+	if err != nil {
+		return err
+	}
+
+	manifest := syncdir.Manifest(files)
+	upload, remove := syncdir.Diff(old, manifest)
+
+	if uploadAll {
+		upload = make([]string, 0, len(files))
+		for k := range files {
+			upload = append(upload, k)
+		}
+		sort.Strings(upload)
+	}
+
+	current := make(map[string]string, len(old))
+	for k, v := range old {
+		current[k] = v
+	}
+
+	var errs *multierror.Error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, d.Get("upload_concurrency").(int))
+	uploader := s3manager.NewUploaderWithClient(conn)
+	template := expandS3BucketObjectsSyncUploadInput(d)
+
+	for _, k := range upload {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(f *syncdir.File) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			log.Printf("[DEBUG] Uploading S3 Bucket (%s) Object (%s) from %s", bucket, f.Key, f.Path)
+			err := resourceAwsS3BucketObjectsSyncUpload(uploader, *template, f)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error uploading S3 Bucket (%s) Object (%s): %w", bucket, f.Key, err))
+				return
+			}
+
+			current[f.Key] = manifest[f.Key]
+		}(files[k])
+	}
+
+	wg.Wait()
+
+	if err := errs.ErrorOrNil(); err != nil {
+		d.Set("manifest", current)
+		return err
+	}
+
+	if err := deleteS3BucketObjectsSyncKeys(conn, bucket, remove); err != nil {
+		d.Set("manifest", current)
+		return fmt.Errorf("error deleting removed objects from S3 Bucket (%s): %w", bucket, err)
+	}
+
+	if d.Get("delete_stray_keys").(bool) {
+		stray, err := findS3BucketObjectsSyncStrayKeys(conn, bucket, d.Get("key_prefix").(string), manifest)
+
+		if err != nil {
+			d.Set("manifest", manifest)
+			return err
+		}
+
+		if err := deleteS3BucketObjectsSyncKeys(conn, bucket, stray); err != nil {
+			d.Set("manifest", manifest)
+			return fmt.Errorf("error deleting stray objects from S3 Bucket (%s): %w", bucket, err)
+		}
+	}
+
+	if err := d.Set("manifest", manifest); err != nil {
+		return fmt.Errorf("error setting manifest: %w", err)
+	}
+
+	return nil
+}
+
+// resourceAwsS3BucketObjectsSyncUpload uploads a file, completing a copy of the input
+// holding the attributes common to all objects.
+func resourceAwsS3BucketObjectsSyncUpload(uploader *s3manager.Uploader, input s3manager.UploadInput, f *syncdir.File) error {
+	file, err := os.Open(f.Path)
+
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("[WARN] Error closing S3 bucket object source (%s): %s", f.Path, err)
+		}
+	}()
+
+	input.Body = file
+	input.ContentType = aws.String(f.ContentType)
+	input.Key = aws.String(f.Key)
+
+	if f.CacheControl != "" {
+		input.CacheControl = aws.String(f.CacheControl)
+	}
+
+	if f.ContentDisposition != "" {
+		input.ContentDisposition = aws.String(f.ContentDisposition)
+	}
+
+	if f.ContentEncoding != "" {
+		input.ContentEncoding = aws.String(f.ContentEncoding)
+	}
+
+	if len(f.Metadata) > 0 {
+		input.Metadata = aws.StringMap(f.Metadata)
+	}
+
+	_, err = uploader.Upload(&input)
+
+	return err
+}
+
+// expandS3BucketObjectsSyncUploadInput returns the upload input attributes common to all objects.
+func expandS3BucketObjectsSyncUploadInput(d *schema.ResourceData) *s3manager.UploadInput {
+	input := &s3manager.UploadInput{
+		ACL:    aws.String(d.Get("acl").(string)),
+		Bucket: aws.String(d.Get("bucket").(string)),
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	return input
+}
+
+// findS3BucketObjectsSyncStrayKeys returns the keys under the prefix which are not in the manifest.
+func findS3BucketObjectsSyncStrayKeys(conn *s3.S3, bucket, keyPrefix string, manifest map[string]string) ([]string, error) {
+	var keys []string
+
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	err := conn.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, object := range page.Contents {
+			if object == nil {
+				continue
+			}
+
+			if _, ok := manifest[aws.StringValue(object.Key)]; !ok {
+				keys = append(keys, aws.StringValue(object.Key))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing S3 Bucket (%s) Objects: %w", bucket, err)
+	}
+
+	return keys, nil
+}
+
+// deleteS3BucketObjectsSyncKeys deletes the current versions of the given keys in batches.
+func deleteS3BucketObjectsSyncKeys(conn *s3.S3, bucket string, keys []string) error {
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > s3BucketObjectsSyncDeleteBatchSize {
+			n = s3BucketObjectsSyncDeleteBatchSize
+		}
+
+		objects := make([]*s3.ObjectIdentifier, 0, n)
+		for _, k := range keys[:n] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(k)})
+		}
+
+		output, err := conn.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		var errs *multierror.Error
+		for _, e := range output.Errors {
+			errs = multierror.Append(errs, fmt.Errorf("error deleting S3 Bucket (%s) Object (%s): %s: %s", bucket, aws.StringValue(e.Key), aws.StringValue(e.Code), aws.StringValue(e.Message)))
+		}
+
+		if err := errs.ErrorOrNil(); err != nil {
+			return err
+		}
+
+		keys = keys[n:]
+	}
+
+	return nil
+}
+
+func expandS3BucketObjectsSyncOptions(d resourceGetter) syncdir.Options {
+	opts := syncdir.Options{
+		KeyPrefix:          d.Get("key_prefix").(string),
+		DefaultContentType: d.Get("default_content_type").(string),
+	}
+
+	if v, ok := d.Get("exclude").(*schema.Set); ok {
+		opts.Exclude = aws.StringValueSlice(expandStringSet(v))
+	}
+
+	for _, tfMapRaw := range d.Get("rule").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		opts.Rules = append(opts.Rules, syncdir.Rule{
+			CacheControl:       tfMap["cache_control"].(string),
+			ContentDisposition: tfMap["content_disposition"].(string),
+			ContentEncoding:    tfMap["content_encoding"].(string),
+			ContentType:        tfMap["content_type"].(string),
+			Metadata:           aws.StringValueMap(stringMapToPointers(tfMap["metadata"].(map[string]interface{}))),
+			Pattern:            tfMap["pattern"].(string),
+		})
+	}
+
+	return opts
+}
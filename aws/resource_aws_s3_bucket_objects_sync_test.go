@@ -0,0 +1,260 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAWSS3BucketObjectsSync_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	dir := testAccAWSS3BucketObjectsSyncCreateTempDir(t, map[string]string{
+		"index.html":    "<html></html>",
+		"css/site.css":  "body {}",
+		"js/app.js":     "console.log(1)",
+		"js/.DS_Store":  "junk",
+		"img/logo.data": "\x89PNG\r\n\x1a\n",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "4"),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest.site/index.html"),
+					resource.TestCheckNoResourceAttr(resourceName, "manifest.site/js/.DS_Store"),
+					testAccCheckAWSS3BucketObjectsSyncObject(resourceName, "site/index.html", "text/html; charset=utf-8", "max-age=300"),
+					testAccCheckAWSS3BucketObjectsSyncObject(resourceName, "site/js/app.js", "", "max-age=31536000"),
+					testAccCheckAWSS3BucketObjectsSyncObject(resourceName, "site/img/logo.data", "image/png", "max-age=31536000"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(dir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(filepath.Join(dir, "index.html"), []byte("<html><body></body></html>"), 0644); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccAWSS3BucketObjectsSyncConfig(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "3"),
+					resource.TestCheckNoResourceAttr(resourceName, "manifest.site/css/site.css"),
+					testAccCheckAWSS3BucketObjectsSyncObjectGone(resourceName, "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketObjectsSync_deleteStrayKeys(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_objects_sync.test"
+	dir := testAccAWSS3BucketObjectsSyncCreateTempDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, s3.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketObjectsSyncDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketObjectsSyncConfigDeleteStrayKeys(rName, dir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifest.%", "1"),
+					testAccCheckAWSS3BucketObjectsSyncObjectGone(resourceName, "site/stray.txt"),
+					testAccCheckAWSS3BucketObjectsSyncObjectGone(resourceName, "site/nested/stray.txt"),
+				),
+				// The deleted stray objects are planned for re-creation.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketObjectsSyncDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_objects_sync" {
+			continue
+		}
+
+		output, err := conn.ListObjectsV2(&s3.ListObjectsV2Input{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Prefix: aws.String(rs.Primary.Attributes["key_prefix"]),
+		})
+
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Contents) > 0 {
+			return fmt.Errorf("S3 Bucket Objects Sync %s still has %d objects", rs.Primary.ID, len(output.Contents))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3BucketObjectsSyncObject(n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn()
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading S3 Bucket Object (%s): %w", key, err)
+		}
+
+		if contentType != "" && aws.StringValue(output.ContentType) != contentType {
+			return fmt.Errorf("S3 Bucket Object (%s) content type: expected %q, got %q", key, contentType, aws.StringValue(output.ContentType))
+		}
+
+		if aws.StringValue(output.CacheControl) != cacheControl {
+			return fmt.Errorf("S3 Bucket Object (%s) cache control: expected %q, got %q", key, cacheControl, aws.StringValue(output.CacheControl))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSS3BucketObjectsSyncObjectGone(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn()
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if isAWSErrRequestFailureStatusCode(err, 404) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket Object (%s) still exists", key)
+	}
+}
+
+func testAccAWSS3BucketObjectsSyncCreateTempDir(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func testAccAWSS3BucketObjectsSyncConfig(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[2]q
+  exclude    = [".DS_Store"]
+
+  rule {
+    pattern       = "**"
+    cache_control = "max-age=300"
+  }
+
+  rule {
+    pattern       = "js/**"
+    cache_control = "max-age=31536000"
+  }
+
+  rule {
+    pattern       = "img/**"
+    cache_control = "max-age=31536000"
+  }
+}
+`, rName, dir)
+}
+
+func testAccAWSS3BucketObjectsSyncConfigDeleteStrayKeys(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "stray" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "site/stray.txt"
+  content = "stray"
+
+  lifecycle {
+    ignore_changes = all
+  }
+}
+
+resource "aws_s3_bucket_object" "nested_stray" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "site/nested/stray.txt"
+  content = "stray"
+
+  lifecycle {
+    ignore_changes = all
+  }
+}
+
+resource "aws_s3_bucket_objects_sync" "test" {
+  bucket            = aws_s3_bucket.test.bucket
+  key_prefix        = "site/"
+  source_dir        = %[2]q
+  delete_stray_keys = true
+
+  depends_on = [aws_s3_bucket_object.stray, aws_s3_bucket_object.nested_stray]
+}
+`, rName, dir)
+}
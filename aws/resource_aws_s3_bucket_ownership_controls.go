@@ -49,7 +49,7 @@ func resourceAwsS3BucketOwnershipControls() *schema.Resource {
 }
 
 func resourceAwsS3BucketOwnershipControlsCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	bucket := d.Get("bucket").(string)
 
@@ -72,7 +72,7 @@ func resourceAwsS3BucketOwnershipControlsCreate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsS3BucketOwnershipControlsRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	input := &s3.GetBucketOwnershipControlsInput{
 		Bucket: aws.String(d.Id()),
@@ -114,7 +114,7 @@ func resourceAwsS3BucketOwnershipControlsRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsS3BucketOwnershipControlsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	input := &s3.PutBucketOwnershipControlsInput{
 		Bucket: aws.String(d.Id()),
@@ -133,7 +133,7 @@ func resourceAwsS3BucketOwnershipControlsUpdate(d *schema.ResourceData, meta int
 }
 
 func resourceAwsS3BucketOwnershipControlsDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).s3conn
+	conn := meta.(*AWSClient).s3conn()
 
 	input := &s3.DeleteBucketOwnershipControlsInput{
 		Bucket: aws.String(d.Id()),
@@ -99,7 +99,7 @@ func resourceAwsSagemakerAppImageConfig() *schema.Resource {
 }
 
 func resourceAwsSagemakerAppImageConfigCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	name := d.Get("app_image_config_name").(string)
 	input := &sagemaker.CreateAppImageConfigInput{
@@ -121,7 +121,7 @@ func resourceAwsSagemakerAppImageConfigCreate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsSagemakerAppImageConfigRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	image, err := finder.AppImageConfigByName(conn, d.Id())
 	if err != nil {
@@ -146,7 +146,7 @@ func resourceAwsSagemakerAppImageConfigRead(d *schema.ResourceData, meta interfa
 }
 
 func resourceAwsSagemakerAppImageConfigUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	if d.HasChange("kernel_gateway_image_config") {
 
@@ -170,7 +170,7 @@ func resourceAwsSagemakerAppImageConfigUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsSagemakerAppImageConfigDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).sagemakerconn
+	conn := meta.(*AWSClient).sagemakerconn()
 
 	input := &sagemaker.DeleteAppImageConfigInput{
 		AppImageConfigName: aws.String(d.Id()),
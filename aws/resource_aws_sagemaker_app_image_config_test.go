@@ -28,7 +28,7 @@ func testSweepSagemakerAppImageConfigs(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).sagemakerconn
+	conn := client.(*AWSClient).sagemakerconn()
 	input := &sagemaker.ListAppImageConfigsInput{}
 	var sweeperErrs *multierror.Error
 
@@ -213,7 +213,7 @@ func TestAccAWSSagemakerAppImageConfig_disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSagemakerAppImageConfigDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sagemaker_app_image_config" {
@@ -244,7 +244,7 @@ func testAccCheckAWSSagemakerAppImageConfigExists(n string, config *sagemaker.De
 			return fmt.Errorf("No sagmaker App Image Config ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn()
 		resp, err := finder.AppImageConfigByName(conn, rs.Primary.ID)
 		if err != nil {
 			return err
@@ -35,7 +35,7 @@ func resourceAwsSecurityHubInviteAccepter() *schema.Resource {
 }
 
 func resourceAwsSecurityHubInviteAccepterCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	log.Print("[DEBUG] Accepting Security Hub invitation")
 
 	invitationId, err := resourceAwsSecurityHubInviteAccepterGetInvitationId(conn, d.Get("master_id").(string))
@@ -78,7 +78,7 @@ func resourceAwsSecurityHubInviteAccepterGetInvitationId(conn *securityhub.Secur
 }
 
 func resourceAwsSecurityHubInviteAccepterRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	log.Print("[DEBUG] Reading Security Hub master account")
 
 	resp, err := conn.GetMasterAccount(&securityhub.GetMasterAccountInput{})
@@ -106,7 +106,7 @@ func resourceAwsSecurityHubInviteAccepterRead(d *schema.ResourceData, meta inter
 }
 
 func resourceAwsSecurityHubInviteAccepterDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 	log.Print("[DEBUG] Disassociating from Security Hub master account")
 
 	_, err := conn.DisassociateFromMasterAccount(&securityhub.DisassociateFromMasterAccountInput{})
@@ -48,7 +48,7 @@ func testAccCheckAWSSecurityHubInviteAccepterExists(resourceName string) resourc
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 		resp, err := conn.GetMasterAccount(&securityhub.GetMasterAccountInput{})
 
@@ -65,7 +65,7 @@ func testAccCheckAWSSecurityHubInviteAccepterExists(resourceName string) resourc
 }
 
 func testAccCheckAWSSecurityHubInviteAccepterDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_securityhub_invite_accepter" {
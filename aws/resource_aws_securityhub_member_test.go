@@ -71,7 +71,7 @@ func testAccCheckAWSSecurityHubMemberExists(n string, member *securityhub.Member
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 		resp, err := conn.GetMembers(&securityhub.GetMembersInput{
 			AccountIds: []*string{aws.String(rs.Primary.ID)},
@@ -92,7 +92,7 @@ func testAccCheckAWSSecurityHubMemberExists(n string, member *securityhub.Member
 }
 
 func testAccCheckAWSSecurityHubMemberDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_securityhub_member" {
@@ -34,7 +34,7 @@ func resourceAwsSecurityHubOrganizationAdminAccount() *schema.Resource {
 }
 
 func resourceAwsSecurityHubOrganizationAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	adminAccountID := d.Get("admin_account_id").(string)
 
@@ -58,7 +58,7 @@ func resourceAwsSecurityHubOrganizationAdminAccountCreate(d *schema.ResourceData
 }
 
 func resourceAwsSecurityHubOrganizationAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	adminAccount, err := finder.AdminAccount(conn, d.Id())
 
@@ -88,7 +88,7 @@ func resourceAwsSecurityHubOrganizationAdminAccountRead(d *schema.ResourceData,
 }
 
 func resourceAwsSecurityHubOrganizationAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).securityhubconn
+	conn := meta.(*AWSClient).securityhubconn()
 
 	input := &securityhub.DisableOrganizationAdminAccountInput{
 		AdminAccountId: aws.String(d.Id()),
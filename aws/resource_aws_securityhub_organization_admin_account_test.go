@@ -64,7 +64,7 @@ func testAccAwsSecurityHubOrganizationAdminAccount_disappears(t *testing.T) {
 }
 
 func testAccCheckAwsSecurityHubOrganizationAdminAccountDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_securityhub_organization_admin_account" {
@@ -100,7 +100,7 @@ func testAccCheckAwsSecurityHubOrganizationAdminAccountExists(resourceName strin
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn()
 
 		adminAccount, err := finder.AdminAccount(conn, rs.Primary.ID)
 
@@ -88,7 +88,7 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStack() *schema.Res
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate(d *schema.ResourceData, meta interface{}) error {
-	cfConn := meta.(*AWSClient).cfconn
+	cfConn := meta.(*AWSClient).cfconn()
 
 	changeSet, err := createServerlessApplicationRepositoryCloudFormationChangeSet(d, meta.(*AWSClient))
 	if err != nil {
@@ -121,8 +121,8 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate(d *sche
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
-	serverlessConn := meta.(*AWSClient).serverlessapplicationrepositoryconn
-	cfConn := meta.(*AWSClient).cfconn
+	serverlessConn := meta.(*AWSClient).serverlessapplicationrepositoryconn()
+	cfConn := meta.(*AWSClient).cfconn()
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
 	stack, err := cffinder.Stack(cfConn, d.Id())
@@ -206,7 +206,7 @@ func flattenServerlessRepositoryParameterDefinitions(parameterDefinitions []*ser
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
-	cfConn := meta.(*AWSClient).cfconn
+	cfConn := meta.(*AWSClient).cfconn()
 
 	changeSet, err := createServerlessApplicationRepositoryCloudFormationChangeSet(d, meta.(*AWSClient))
 	if err != nil {
@@ -237,7 +237,7 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate(d *sche
 }
 
 func resourceAwsServerlessApplicationRepositoryCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).cfconn
+	conn := meta.(*AWSClient).cfconn()
 
 	requestToken := resource.UniqueId()
 	input := &cloudformation.DeleteStackInput{
@@ -273,7 +273,7 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackImport(d *sche
 		}
 	}
 
-	cfConn := meta.(*AWSClient).cfconn
+	cfConn := meta.(*AWSClient).cfconn()
 	stack, err := cffinder.Stack(cfConn, stackID)
 	if err != nil {
 		return nil, fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): %w", stackID, err)
@@ -285,8 +285,8 @@ func resourceAwsServerlessApplicationRepositoryCloudFormationStackImport(d *sche
 }
 
 func createServerlessApplicationRepositoryCloudFormationChangeSet(d *schema.ResourceData, client *AWSClient) (*cloudformation.DescribeChangeSetOutput, error) {
-	serverlessConn := client.serverlessapplicationrepositoryconn
-	cfConn := client.cfconn
+	serverlessConn := client.serverlessapplicationrepositoryconn()
+	cfConn := client.cfconn()
 
 	stackName := d.Get("name").(string)
 	changeSetRequest := serverlessrepository.CreateCloudFormationChangeSetRequest{
@@ -256,7 +256,7 @@ func testAccCheckAWSSfnExists(n string, sm *sfn.DescribeStateMachineOutput) reso
 			return fmt.Errorf("No Step Function ID set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sfnconn
+		conn := testAccProvider.Meta().(*AWSClient).sfnconn()
 
 		resp, err := conn.DescribeStateMachine(&sfn.DescribeStateMachineInput{
 			StateMachineArn: aws.String(rs.Primary.ID),
@@ -273,7 +273,7 @@ func testAccCheckAWSSfnExists(n string, sm *sfn.DescribeStateMachineOutput) reso
 }
 
 func testAccCheckAWSSfnStateMachineDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sfnconn
+	conn := testAccProvider.Meta().(*AWSClient).sfnconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sfn_state_machine" {
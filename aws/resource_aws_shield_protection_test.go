@@ -189,7 +189,7 @@ func TestAccAWSShieldProtection_Route53(t *testing.T) {
 }
 
 func testAccCheckAWSShieldProtectionDestroy(s *terraform.State) error {
-	shieldconn := testAccProvider.Meta().(*AWSClient).shieldconn
+	shieldconn := testAccProvider.Meta().(*AWSClient).shieldconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_shield_protection" {
@@ -225,7 +225,7 @@ func testAccCheckAWSShieldProtectionExists(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).shieldconn
+		conn := testAccProvider.Meta().(*AWSClient).shieldconn()
 
 		input := &shield.DescribeProtectionInput{
 			ProtectionId: aws.String(rs.Primary.ID),
@@ -242,7 +242,7 @@ func testAccCheckAWSShieldProtectionExists(name string) resource.TestCheckFunc {
 }
 
 func testAccPreCheckAWSShield(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).shieldconn
+	conn := testAccProvider.Meta().(*AWSClient).shieldconn()
 
 	input := &shield.ListProtectionsInput{}
 
@@ -237,7 +237,7 @@ func testAccCheckAWSSignerSigningProfilePermissionExists(res, profileName string
 			return fmt.Errorf("Signing Profile with that ARN does not exist")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).signerconn
+		conn := testAccProvider.Meta().(*AWSClient).signerconn()
 
 		params := &signer.ListProfilePermissionsInput{
 			ProfileName: aws.String(profileName),
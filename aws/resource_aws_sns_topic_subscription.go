@@ -117,7 +117,7 @@ func resourceAwsSnsTopicSubscription() *schema.Resource {
 }
 
 func resourceAwsSnsTopicSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).snsconn
+	conn := meta.(*AWSClient).snsconn()
 
 	input := &sns.SubscribeInput{
 		Attributes:            expandSNSSubscriptionAttributes(d),
@@ -164,7 +164,7 @@ func resourceAwsSnsTopicSubscriptionCreate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSnsTopicSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).snsconn
+	conn := meta.(*AWSClient).snsconn()
 
 	log.Printf("[DEBUG] Loading subscription %s", d.Id())
 
@@ -217,7 +217,7 @@ func resourceAwsSnsTopicSubscriptionRead(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsSnsTopicSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).snsconn
+	conn := meta.(*AWSClient).snsconn()
 
 	if d.HasChange("raw_message_delivery") {
 		if err := snsSubscriptionAttributeUpdate(conn, d.Id(), "RawMessageDelivery", fmt.Sprintf("%t", d.Get("raw_message_delivery").(bool))); err != nil {
@@ -269,7 +269,7 @@ func resourceAwsSnsTopicSubscriptionUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsSnsTopicSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).snsconn
+	conn := meta.(*AWSClient).snsconn()
 
 	log.Printf("[DEBUG] SNS delete topic subscription: %s", d.Id())
 	_, err := conn.Unsubscribe(&sns.UnsubscribeInput{
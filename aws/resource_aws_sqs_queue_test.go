@@ -37,7 +37,7 @@ func testSweepSqsQueues(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*AWSClient).sqsconn
+	conn := client.(*AWSClient).sqsconn()
 	input := &sqs.ListQueuesInput{}
 	var sweeperErrs *multierror.Error
 
@@ -466,7 +466,7 @@ func TestAccAWSSQSQueue_Encryption(t *testing.T) {
 }
 
 func testAccCheckAWSSQSQueueDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sqsconn
+	conn := testAccProvider.Meta().(*AWSClient).sqsconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sqs_queue" {
@@ -534,7 +534,7 @@ func testAccCheckAWSSQSQueueExists(resourceName string, queueAttributes *map[str
 			return fmt.Errorf("No Queue URL specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		conn := testAccProvider.Meta().(*AWSClient).sqsconn()
 
 		input := &sqs.GetQueueAttributesInput{
 			QueueUrl:       aws.String(rs.Primary.ID),
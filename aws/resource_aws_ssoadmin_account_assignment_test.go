@@ -31,7 +31,7 @@ func testSweepSsoAdminAccountAssignments(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 
-	conn := client.(*AWSClient).ssoadminconn
+	conn := client.(*AWSClient).ssoadminconn()
 	var sweeperErrs *multierror.Error
 
 	// Need to Read the SSO Instance first; assumes the first instance returned
@@ -227,7 +227,7 @@ func TestAccAWSSSOAdminAccountAssignment_Disappears(t *testing.T) {
 }
 
 func testAccCheckAWSSSOAdminAccountAssignmentDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+	conn := testAccProvider.Meta().(*AWSClient).ssoadminconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_ssoadmin_account_assignment" {
@@ -275,7 +275,7 @@ func testAccCheckAWSSSOAdminAccountAssignmentExists(resourceName string) resourc
 			return fmt.Errorf("Resource (%s) ID not set", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn
+		conn := testAccProvider.Meta().(*AWSClient).ssoadminconn()
 
 		idParts, err := parseSsoAdminAccountAssignmentID(rs.Primary.ID)
 
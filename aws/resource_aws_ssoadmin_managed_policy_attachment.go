@@ -51,7 +51,7 @@ func resourceAwsSsoAdminManagedPolicyAttachment() *schema.Resource {
 }
 
 func resourceAwsSsoAdminManagedPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	instanceArn := d.Get("instance_arn").(string)
 	managedPolicyArn := d.Get("managed_policy_arn").(string)
@@ -79,7 +79,7 @@ func resourceAwsSsoAdminManagedPolicyAttachmentCreate(d *schema.ResourceData, me
 }
 
 func resourceAwsSsoAdminManagedPolicyAttachmentRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	managedPolicyArn, permissionSetArn, instanceArn, err := parseSsoAdminManagedPolicyAttachmentID(d.Id())
 	if err != nil {
@@ -113,7 +113,7 @@ func resourceAwsSsoAdminManagedPolicyAttachmentRead(d *schema.ResourceData, meta
 }
 
 func resourceAwsSsoAdminManagedPolicyAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ssoadminconn
+	conn := meta.(*AWSClient).ssoadminconn()
 
 	managedPolicyArn, permissionSetArn, instanceArn, err := parseSsoAdminManagedPolicyAttachmentID(d.Id())
 	if err != nil {
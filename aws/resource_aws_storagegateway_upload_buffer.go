@@ -38,7 +38,7 @@ func resourceAwsStorageGatewayUploadBuffer() *schema.Resource {
 }
 
 func resourceAwsStorageGatewayUploadBufferCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	diskID := d.Get("disk_id").(string)
 	gatewayARN := d.Get("gateway_arn").(string)
@@ -60,7 +60,7 @@ func resourceAwsStorageGatewayUploadBufferCreate(d *schema.ResourceData, meta in
 }
 
 func resourceAwsStorageGatewayUploadBufferRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).storagegatewayconn
+	conn := meta.(*AWSClient).storagegatewayconn()
 
 	gatewayARN, diskID, err := decodeStorageGatewayUploadBufferID(d.Id())
 	if err != nil {
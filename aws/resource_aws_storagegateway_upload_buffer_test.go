@@ -105,7 +105,7 @@ func testAccCheckAWSStorageGatewayUploadBufferExists(resourceName string) resour
 			return fmt.Errorf("Not found: %s", resourceName)
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn()
 
 		gatewayARN, diskID, err := decodeStorageGatewayUploadBufferID(rs.Primary.ID)
 		if err != nil {
@@ -212,7 +212,7 @@ func testAccCheckAWSTransferUserExists(n string, res *transfer.DescribedUser) re
 			return fmt.Errorf("No Transfer User ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 		userName := rs.Primary.Attributes["user_name"]
 		serverID := rs.Primary.Attributes["server_id"]
 
@@ -233,7 +233,7 @@ func testAccCheckAWSTransferUserExists(n string, res *transfer.DescribedUser) re
 
 func testAccCheckAWSTransferUserDisappears(serverConf *transfer.DescribedServer, userConf *transfer.DescribedUser) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := testAccProvider.Meta().(*AWSClient).transferconn
+		conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 		params := &transfer.DeleteUserInput{
 			ServerId: serverConf.ServerId,
@@ -250,7 +250,7 @@ func testAccCheckAWSTransferUserDisappears(serverConf *transfer.DescribedServer,
 }
 
 func testAccCheckAWSTransferUserDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).transferconn
+	conn := testAccProvider.Meta().(*AWSClient).transferconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_transfer_user" {
@@ -51,7 +51,7 @@ func TestAccAWSVpcEndpointConnectionNotification_basic(t *testing.T) {
 }
 
 func testAccCheckVpcEndpointConnectionNotificationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpc_endpoint_connection_notification" {
@@ -89,7 +89,7 @@ func testAccCheckVpcEndpointConnectionNotificationExists(n string) resource.Test
 			return fmt.Errorf("No VPC Endpoint connection notification ID is set")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 		resp, err := conn.DescribeVpcEndpointConnectionNotifications(&ec2.DescribeVpcEndpointConnectionNotificationsInput{
 			ConnectionNotificationId: aws.String(rs.Primary.ID),
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
 )
 
 func resourceAwsVpnConnectionRoute() *schema.Resource {
@@ -21,6 +21,10 @@ func resourceAwsVpnConnectionRoute() *schema.Resource {
 		Read:   resourceAwsVpnConnectionRouteRead,
 		Delete: resourceAwsVpnConnectionRouteDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"destination_cidr_block": {
 				Type:     schema.TypeString,
@@ -38,7 +42,7 @@ func resourceAwsVpnConnectionRoute() *schema.Resource {
 }
 
 func resourceAwsVpnConnectionRouteCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	cidrBlock := d.Get("destination_cidr_block").(string)
 	vpnConnectionId := d.Get("vpn_connection_id").(string)
@@ -55,7 +59,7 @@ func resourceAwsVpnConnectionRouteCreate(d *schema.ResourceData, meta interface{
 	}
 
 	// Store the ID by the only two data we have available to us.
-	d.SetId(fmt.Sprintf("%s:%s", *createOpts.DestinationCidrBlock, *createOpts.VpnConnectionId))
+	d.SetId(tfec2.VpnConnectionRouteCreateID(cidrBlock, vpnConnectionId))
 
 	stateConf := resource.StateChangeConf{
 		Pending: []string{"pending"},
@@ -78,9 +82,12 @@ func resourceAwsVpnConnectionRouteCreate(d *schema.ResourceData, meta interface{
 }
 
 func resourceAwsVpnConnectionRouteRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
-	cidrBlock, vpnConnectionId := resourceAwsVpnConnectionRouteParseId(d.Id())
+	cidrBlock, vpnConnectionId, err := tfec2.VpnConnectionRouteParseID(d.Id())
+	if err != nil {
+		return err
+	}
 
 	route, err := findConnectionRoute(conn, cidrBlock, vpnConnectionId)
 	if err != nil {
@@ -89,13 +96,17 @@ func resourceAwsVpnConnectionRouteRead(d *schema.ResourceData, meta interface{})
 	if route == nil {
 		// Something other than terraform eliminated the route.
 		d.SetId("")
+		return nil
 	}
 
+	d.Set("destination_cidr_block", cidrBlock)
+	d.Set("vpn_connection_id", vpnConnectionId)
+
 	return nil
 }
 
 func resourceAwsVpnConnectionRouteDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	cidrBlock := d.Get("destination_cidr_block").(string)
 	vpnConnectionId := d.Get("vpn_connection_id").(string)
@@ -161,8 +172,3 @@ func findConnectionRoute(conn *ec2.EC2, cidrBlock, vpnConnectionId string) (*ec2
 	}
 	return nil, nil
 }
-
-func resourceAwsVpnConnectionRouteParseId(id string) (string, string) {
-	parts := strings.SplitN(id, ":", 2)
-	return parts[0], parts[1]
-}
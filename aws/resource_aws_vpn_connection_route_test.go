@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfec2 "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/ec2"
 )
 
 func TestAccAWSVpnConnectionRoute_basic(t *testing.T) {
@@ -26,6 +27,11 @@ func TestAccAWSVpnConnectionRoute_basic(t *testing.T) {
 					testAccAwsVpnConnectionRoute("aws_vpn_connection_route.foo"),
 				),
 			},
+			{
+				ResourceName:      "aws_vpn_connection_route.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAwsVpnConnectionRouteConfigUpdate(rBgpAsn),
 				Check: resource.ComposeTestCheckFunc(
@@ -37,13 +43,16 @@ func TestAccAWSVpnConnectionRoute_basic(t *testing.T) {
 }
 
 func testAccAwsVpnConnectionRouteDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_vpn_connection_route" {
 			continue
 		}
 
-		cidrBlock, vpnConnectionId := resourceAwsVpnConnectionRouteParseId(rs.Primary.ID)
+		cidrBlock, vpnConnectionId, err := tfec2.VpnConnectionRouteParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
 
 		routeFilters := []*ec2.Filter{
 			{
@@ -105,7 +114,10 @@ func testAccAwsVpnConnectionRoute(vpnConnectionRouteResource string) resource.Te
 			return fmt.Errorf("Not found: %s", vpnConnectionRouteResource)
 		}
 
-		cidrBlock, vpnConnectionId := resourceAwsVpnConnectionRouteParseId(route.Primary.ID)
+		cidrBlock, vpnConnectionId, err := tfec2.VpnConnectionRouteParseID(route.Primary.ID)
+		if err != nil {
+			return err
+		}
 
 		routeFilters := []*ec2.Filter{
 			{
@@ -118,9 +130,9 @@ func testAccAwsVpnConnectionRoute(vpnConnectionRouteResource string) resource.Te
 			},
 		}
 
-		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		ec2conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
-		_, err := ec2conn.DescribeVpnConnections(&ec2.DescribeVpnConnectionsInput{
+		_, err = ec2conn.DescribeVpnConnections(&ec2.DescribeVpnConnectionsInput{
 			Filters: routeFilters,
 		})
 		return err
@@ -31,7 +31,7 @@ func resourceAwsVpnGatewayRoutePropagation() *schema.Resource {
 }
 
 func resourceAwsVpnGatewayRoutePropagationEnable(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	gwID := d.Get("vpn_gateway_id").(string)
 	rtID := d.Get("route_table_id").(string)
@@ -50,7 +50,7 @@ func resourceAwsVpnGatewayRoutePropagationEnable(d *schema.ResourceData, meta in
 }
 
 func resourceAwsVpnGatewayRoutePropagationDisable(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	gwID := d.Get("vpn_gateway_id").(string)
 	rtID := d.Get("route_table_id").(string)
@@ -68,7 +68,7 @@ func resourceAwsVpnGatewayRoutePropagationDisable(d *schema.ResourceData, meta i
 }
 
 func resourceAwsVpnGatewayRoutePropagationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	conn := meta.(*AWSClient).ec2conn()
 
 	gwID := d.Get("vpn_gateway_id").(string)
 	rtID := d.Get("route_table_id").(string)
@@ -22,7 +22,7 @@ func TestAccAWSVPNGatewayRoutePropagation_basic(t *testing.T) {
 			{
 				Config: testAccAWSVPNGatewayRoutePropagation_basic,
 				Check: func(state *terraform.State) error {
-					conn := testAccProvider.Meta().(*AWSClient).ec2conn
+					conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 					rs := state.RootModule().Resources["aws_vpn_gateway_route_propagation.foo"]
 					if rs == nil {
@@ -56,7 +56,7 @@ func TestAccAWSVPNGatewayRoutePropagation_basic(t *testing.T) {
 			},
 		},
 		CheckDestroy: func(state *terraform.State) error {
-			conn := testAccProvider.Meta().(*AWSClient).ec2conn
+			conn := testAccProvider.Meta().(*AWSClient).ec2conn()
 
 			rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, rtID)()
 			if err != nil {
@@ -109,7 +109,7 @@ func TestAccAWSWafRegionalWebAclAssociation_ResourceArn_ApiGatewayStage(t *testi
 }
 
 func testAccCheckWafRegionalWebAclAssociationDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+	conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_wafregional_web_acl_association" {
@@ -151,7 +151,7 @@ func testAccCheckWafRegionalWebAclAssociationExists(n string) resource.TestCheck
 
 		resourceArn := resourceAwsWafRegionalWebAclAssociationParseId(rs.Primary.ID)
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 
 		input := &wafregional.GetWebACLForResourceInput{
 			ResourceArn: aws.String(resourceArn),
@@ -178,7 +178,7 @@ func testAccCheckWafRegionalWebAclAssociationDisappears(resourceName string) res
 
 		resourceArn := resourceAwsWafRegionalWebAclAssociationParseId(rs.Primary.ID)
 
-		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn
+		conn := testAccProvider.Meta().(*AWSClient).wafregionalconn()
 
 		input := &wafregional.DisassociateWebACLInput{
 			ResourceArn: aws.String(resourceArn),
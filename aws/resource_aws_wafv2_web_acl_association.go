@@ -51,7 +51,7 @@ func resourceAwsWafv2WebACLAssociation() *schema.Resource {
 }
 
 func resourceAwsWafv2WebACLAssociationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafv2conn
+	conn := meta.(*AWSClient).wafv2conn()
 	resourceArn := d.Get("resource_arn").(string)
 	webAclArn := d.Get("web_acl_arn").(string)
 	params := &wafv2.AssociateWebACLInput{
@@ -84,7 +84,7 @@ func resourceAwsWafv2WebACLAssociationCreate(d *schema.ResourceData, meta interf
 }
 
 func resourceAwsWafv2WebACLAssociationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafv2conn
+	conn := meta.(*AWSClient).wafv2conn()
 	resourceArn := d.Get("resource_arn").(string)
 	webAclArn := d.Get("web_acl_arn").(string)
 	params := &wafv2.GetWebACLForResourceInput{
@@ -110,7 +110,7 @@ func resourceAwsWafv2WebACLAssociationRead(d *schema.ResourceData, meta interfac
 }
 
 func resourceAwsWafv2WebACLAssociationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).wafv2conn
+	conn := meta.(*AWSClient).wafv2conn()
 
 	log.Printf("[INFO] Deleting WAFv2 Web ACL Association %s", d.Id())
 
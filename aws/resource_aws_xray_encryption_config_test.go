@@ -62,7 +62,7 @@ func testAccCheckXrayEncryptionConfigExists(n string, EncryptionConfig *xray.Enc
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No XRay Encryption Config ID is set")
 		}
-		conn := testAccProvider.Meta().(*AWSClient).xrayconn
+		conn := testAccProvider.Meta().(*AWSClient).xrayconn()
 
 		config, err := conn.GetEncryptionConfig(&xray.GetEncryptionConfigInput{})
 
@@ -185,7 +185,7 @@ func testAccCheckXraySamplingRuleExists(n string, samplingRule *xray.SamplingRul
 		if rs.Primary.ID == "" {
 			return fmt.Errorf("No XRay Sampling Rule ID is set")
 		}
-		conn := testAccProvider.Meta().(*AWSClient).xrayconn
+		conn := testAccProvider.Meta().(*AWSClient).xrayconn()
 
 		rule, err := getXraySamplingRule(conn, rs.Primary.ID)
 
@@ -205,7 +205,7 @@ func testAccCheckAWSXraySamplingRuleDestroy(s *terraform.State) error {
 			continue
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).xrayconn
+		conn := testAccProvider.Meta().(*AWSClient).xrayconn()
 
 		rule, err := getXraySamplingRule(conn, rs.Primary.ID)
 
@@ -222,7 +222,7 @@ func testAccCheckAWSXraySamplingRuleDestroy(s *terraform.State) error {
 }
 
 func testAccPreCheckAWSXray(t *testing.T) {
-	conn := testAccProvider.Meta().(*AWSClient).xrayconn
+	conn := testAccProvider.Meta().(*AWSClient).xrayconn()
 
 	input := &xray.GetSamplingRulesInput{}
 
@@ -8,3 +8,11 @@ package aws
 type resourceDiffer interface {
 	HasChange(string) bool
 }
+
+// resourceGetter exposes the interface for reading the values of a resource
+// Implementations:
+// * schema.ResourceData
+// * schema.ResourceDiff
+type resourceGetter interface {
+	Get(string) interface{}
+}
@@ -47,6 +47,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/mitchellh/copystructure"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/experimental/nullable"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 	"gopkg.in/yaml.v2"
 )
@@ -1875,9 +1876,8 @@ func expandCloudWatchLogMetricTransformations(m map[string]interface{}) []*cloud
 		MetricValue:     aws.String(m["value"].(string)),
 	}
 
-	if m["default_value"].(string) != "" {
-		value, _ := strconv.ParseFloat(m["default_value"].(string), 64)
-		transformation.DefaultValue = aws.Float64(value)
+	if v, null, _ := nullable.Float(m["default_value"].(string)).Value(); !null {
+		transformation.DefaultValue = aws.Float64(v)
 	}
 
 	return []*cloudwatchlogs.MetricTransformation{&transformation}
@@ -1891,11 +1891,7 @@ func flattenCloudWatchLogMetricTransformations(ts []*cloudwatchlogs.MetricTransf
 	m["namespace"] = aws.StringValue(ts[0].MetricNamespace)
 	m["value"] = aws.StringValue(ts[0].MetricValue)
 
-	if ts[0].DefaultValue == nil {
-		m["default_value"] = ""
-	} else {
-		m["default_value"] = strconv.FormatFloat(aws.Float64Value(ts[0].DefaultValue), 'f', -1, 64)
-	}
+	m["default_value"] = string(nullable.NewFloat(ts[0].DefaultValue))
 
 	mts = append(mts, m)
 
@@ -3,9 +3,11 @@ package aws
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
@@ -117,3 +119,81 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 
 	return nil
 }
+
+// customizeDiffValidateTags returns a CustomizeDiffFunc validating the planned
+// tags against the service tag constraints (see keyvaluetags.ServiceTagConstraints).
+// It must follow SetTagsDiff so that provider default tags are included.
+func customizeDiffValidateTags(serviceName string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown("tags_all") {
+			return nil
+		}
+
+		tags := keyvaluetags.New(diff.Get("tags_all").(map[string]interface{})).IgnoreConfig(meta.(*AWSClient).IgnoreTagsConfig)
+
+		if err := tags.Validate(serviceName); err != nil {
+			return fmt.Errorf("invalid tags: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// wrapResourceTagPolicy evaluates the provider tag policy against the tags of the
+// named resource when planning. Resources without a configurable "tags" argument are
+// not wrapped. For resources supporting provider default tags, the policy is
+// evaluated against the resource tags merged with the default tags, minus ignored tags.
+func wrapResourceTagPolicy(name string, r *schema.Resource) {
+	if v, ok := r.Schema["tags"]; !ok || v.Type != schema.TypeMap || !(v.Optional || v.Required) {
+		return
+	}
+
+	_, defaultTags := r.Schema["tags_all"]
+	customizeDiff := r.CustomizeDiff
+
+	r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if client, ok := meta.(*AWSClient); ok {
+			if err := checkTagPolicy(name, diff, client, defaultTags); err != nil {
+				return err
+			}
+		}
+
+		if customizeDiff == nil {
+			return nil
+		}
+
+		return customizeDiff(ctx, diff, meta)
+	}
+}
+
+func checkTagPolicy(name string, diff *schema.ResourceDiff, client *AWSClient, defaultTags bool) error {
+	if client.TagPolicyConfig == nil || !diff.NewValueKnown("tags") {
+		return nil
+	}
+
+	tags := keyvaluetags.New(diff.Get("tags").(map[string]interface{}))
+
+	if defaultTags {
+		tags = client.DefaultTagsConfig.MergeTags(tags)
+	}
+
+	tags = tags.IgnoreConfig(client.IgnoreTagsConfig)
+
+	resourceName := name
+	if diff.Id() != "" {
+		resourceName = fmt.Sprintf("%s (%s)", name, diff.Id())
+	}
+
+	var errs *multierror.Error
+
+	for _, violation := range client.TagPolicyConfig.Check(tags) {
+		if client.TagPolicyConfig.Warn() {
+			log.Printf("[WARN] %s does not conform to the provider tag_policy: %s", resourceName, violation)
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("%s does not conform to the provider tag_policy: %s", resourceName, violation))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -19,6 +19,7 @@ func main() {
 
 	if debugMode {
 		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/aws", opts)
+		aws.CloseAPITraces()
 
 		if err != nil {
 			log.Fatal(err.Error())
@@ -28,4 +29,5 @@ func main() {
 	}
 
 	plugin.Serve(opts)
+	aws.CloseAPITraces()
 }